@@ -3,22 +3,82 @@ package main
 import (
 	"context"
 	"kn-assignment/infrastructure"
+	smtpadapter "kn-assignment/internal/adapter/smtp-adapter"
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/port"
 	authsvc "kn-assignment/internal/core/service/auth-svc"
+	availabilitysvc "kn-assignment/internal/core/service/availability-svc"
+	checklistsvc "kn-assignment/internal/core/service/checklist-svc"
+	commentsvc "kn-assignment/internal/core/service/comment-svc"
+	customfieldsvc "kn-assignment/internal/core/service/customfield-svc"
+	handoffsvc "kn-assignment/internal/core/service/handoff-svc"
+	linksvc "kn-assignment/internal/core/service/link-svc"
+	notificationsvc "kn-assignment/internal/core/service/notification-svc"
+	poolsvc "kn-assignment/internal/core/service/pool-svc"
+	projectsvc "kn-assignment/internal/core/service/project-svc"
+	reviewsvc "kn-assignment/internal/core/service/review-svc"
+	slasvc "kn-assignment/internal/core/service/sla-svc"
+	sprintsvc "kn-assignment/internal/core/service/sprint-svc"
 	tasksvc "kn-assignment/internal/core/service/task-svc"
+	templatesvc "kn-assignment/internal/core/service/template-svc"
+	usersvc "kn-assignment/internal/core/service/user-svc"
+	viewsvc "kn-assignment/internal/core/service/view-svc"
+	watchersvc "kn-assignment/internal/core/service/watcher-svc"
+	wipsvc "kn-assignment/internal/core/service/wip-svc"
 	authhdl "kn-assignment/internal/handler/auth-hdl"
+	availabilityhdl "kn-assignment/internal/handler/availability-hdl"
+	checklisthdl "kn-assignment/internal/handler/checklist-hdl"
+	commenthdl "kn-assignment/internal/handler/comment-hdl"
+	customfieldhdl "kn-assignment/internal/handler/customfield-hdl"
+	graphqlhdl "kn-assignment/internal/handler/graphql-hdl"
+	grpchdl "kn-assignment/internal/handler/grpc-hdl"
+	handoffhdl "kn-assignment/internal/handler/handoff-hdl"
+	linkhdl "kn-assignment/internal/handler/link-hdl"
+	notificationhdl "kn-assignment/internal/handler/notification-hdl"
+	poolhdl "kn-assignment/internal/handler/pool-hdl"
+	projecthdl "kn-assignment/internal/handler/project-hdl"
+	reviewhdl "kn-assignment/internal/handler/review-hdl"
+	slahdl "kn-assignment/internal/handler/sla-hdl"
+	sprinthdl "kn-assignment/internal/handler/sprint-hdl"
 	taskhdl "kn-assignment/internal/handler/task-hdl"
+	templatehdl "kn-assignment/internal/handler/template-hdl"
+	userhdl "kn-assignment/internal/handler/user-hdl"
+	viewhdl "kn-assignment/internal/handler/view-hdl"
+	watcherhdl "kn-assignment/internal/handler/watcher-hdl"
+	wiphdl "kn-assignment/internal/handler/wip-hdl"
 	"kn-assignment/internal/log"
 	"kn-assignment/internal/middleware"
 	authrepo "kn-assignment/internal/repository/postgres/auth-repo"
+	availabilityrepo "kn-assignment/internal/repository/postgres/availability-repo"
+	checklistrepo "kn-assignment/internal/repository/postgres/checklist-repo"
+	commentrepo "kn-assignment/internal/repository/postgres/comment-repo"
+	customfieldrepo "kn-assignment/internal/repository/postgres/customfield-repo"
+	handoffrepo "kn-assignment/internal/repository/postgres/handoff-repo"
+	linkrepo "kn-assignment/internal/repository/postgres/link-repo"
+	notificationrepo "kn-assignment/internal/repository/postgres/notification-repo"
+	poolrepo "kn-assignment/internal/repository/postgres/pool-repo"
+	projectrepo "kn-assignment/internal/repository/postgres/project-repo"
+	reviewrepo "kn-assignment/internal/repository/postgres/review-repo"
+	slarepo "kn-assignment/internal/repository/postgres/sla-repo"
+	sprintrepo "kn-assignment/internal/repository/postgres/sprint-repo"
 	taskrepo "kn-assignment/internal/repository/postgres/task-repo"
+	templaterepo "kn-assignment/internal/repository/postgres/template-repo"
 	userrepo "kn-assignment/internal/repository/postgres/user-repo"
+	viewrepo "kn-assignment/internal/repository/postgres/view-repo"
+	watcherrepo "kn-assignment/internal/repository/postgres/watcher-repo"
+	wiprepo "kn-assignment/internal/repository/postgres/wip-repo"
 	"kn-assignment/internal/router"
+	"kn-assignment/internal/worker"
 	"kn-assignment/property"
 	"kn-assignment/server"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	// embed the timezone database, the image does not ship one
+	_ "time/tzdata"
+
+	"google.golang.org/grpc"
 )
 
 // @securityDefinitions.apiKey BearerAuth
@@ -39,17 +99,98 @@ func main() {
 	flavor := infrastructure.NewQueryBuilder()
 
 	// init repository
-	taskRepository := taskrepo.New(pgx, scanapi, flavor)
+	if prefix := property.Get().Server.TaskKeyPrefix; !domain.IsValidKeyPrefix(prefix) {
+		log.Fatalf(ctx, "invalid TASK_KEY_PREFIX %q", prefix)
+	}
+	taskRepository := taskrepo.New(pgx, scanapi, flavor, property.Get().Server.TaskKeyPrefix)
 	authRepository := authrepo.New(pgx, scanapi, flavor)
 	userRepository := userrepo.New(pgx, scanapi, flavor)
+	viewRepository := viewrepo.New(pgx, scanapi, flavor)
+	templateRepository := templaterepo.New(pgx, scanapi, flavor)
+	checklistRepository := checklistrepo.New(pgx, scanapi, flavor)
+	projectRepository := projectrepo.New(pgx, scanapi, flavor)
+	customFieldRepository := customfieldrepo.New(pgx, scanapi, flavor)
+	slaRepository := slarepo.New(pgx, scanapi, flavor)
+	handoffRepository := handoffrepo.New(pgx, scanapi, flavor)
+	reviewRepository := reviewrepo.New(pgx, scanapi, flavor)
+	linkRepository := linkrepo.New(pgx, scanapi, flavor)
+	commentRepository := commentrepo.New(pgx, scanapi, flavor)
+	poolRepository := poolrepo.New(pgx, scanapi, flavor)
+	notificationRepository := notificationrepo.New(pgx, scanapi, flavor)
+	watcherRepository := watcherrepo.New(pgx, scanapi, flavor)
+	sprintRepository := sprintrepo.New(pgx, scanapi, flavor)
+	wipRepository := wiprepo.New(pgx, scanapi, flavor)
+	availabilityRepository := availabilityrepo.New(pgx, scanapi, flavor)
+
+	assignStrategy := domain.AssignStrategy(property.Get().Server.AutoAssignStrategy)
+	if !assignStrategy.IsValid() {
+		log.Fatalf(ctx, "invalid AUTO_ASSIGN_STRATEGY %q", assignStrategy)
+	}
+	if limit := property.Get().Server.WIPLimit; limit < 0 {
+		log.Fatalf(ctx, "invalid WIP_LIMIT %d", limit)
+	}
+
+	// init adapter
+	var mailer port.Mailer
+	if server := property.Get().Server; server.SMTPHost != "" {
+		var err error
+		mailer, err = smtpadapter.New(server.SMTPHost, server.SMTPPort, server.SMTPUsername, property.Get().Secret.SMTPPassword, server.SMTPFrom)
+		if err != nil {
+			log.Fatalf(ctx, "invalid SMTP_FROM: %s", err.Error())
+		}
+	}
+	if hour := property.Get().Server.DigestHour; hour < 0 || hour > 23 {
+		log.Fatalf(ctx, "invalid DIGEST_HOUR %d", hour)
+	}
 
 	// init service
-	taskService := tasksvc.New(taskRepository, userRepository)
+	taskService := tasksvc.New(taskRepository, userRepository, checklistRepository, projectRepository, customFieldRepository, slaRepository,
+		notificationRepository, watcherRepository, linkRepository, wipRepository, availabilityRepository,
+		assignStrategy, property.Get().Server.WIPLimit)
 	authService := authsvc.New(authRepository)
+	userService := usersvc.New(userRepository)
+	viewService := viewsvc.New(viewRepository)
+	templateService := templatesvc.New(templateRepository, availabilityRepository, taskService)
+	checklistService := checklistsvc.New(checklistRepository, taskRepository)
+	projectService := projectsvc.New(projectRepository)
+	customFieldService := customfieldsvc.New(customFieldRepository, projectRepository)
+	slaService := slasvc.New(slaRepository, taskRepository, projectRepository, notificationRepository, watcherRepository, availabilityRepository, taskService)
+	handoffService := handoffsvc.New(handoffRepository, taskRepository, userRepository, slaRepository, notificationRepository, watcherRepository, taskService)
+	reviewService := reviewsvc.New(reviewRepository, taskRepository, slaRepository, notificationRepository, taskService)
+	linkService := linksvc.New(linkRepository, taskRepository, slaRepository, notificationRepository, taskService)
+	commentService := commentsvc.New(commentRepository, taskRepository, userRepository, linkRepository, notificationRepository)
+	poolService := poolsvc.New(poolRepository, taskRepository, userRepository, slaRepository, notificationRepository, watcherRepository, taskService,
+		property.Get().Server.PoolClaimLimit)
+	watcherService := watchersvc.New(watcherRepository, taskRepository)
+	sprintService := sprintsvc.New(sprintRepository, taskRepository, userRepository)
+	wipService := wipsvc.New(wipRepository, property.Get().Server.WIPLimit)
+	availabilityService := availabilitysvc.New(availabilityRepository, userRepository)
+	notificationService := notificationsvc.New(notificationRepository, taskRepository, mailer,
+		property.Get().Server.NotificationDueSoon, property.Get().Server.NotificationRetain, property.Get().Server.DigestHour)
 
 	// init handler
-	taskHandler := taskhdl.New(taskService)
+	taskHandler := taskhdl.New(taskService, viewService, linkService)
 	authHandler := authhdl.New(authService)
+	graphqlHandler := graphqlhdl.New(taskService, userService, linkService)
+	viewHandler := viewhdl.New(viewService)
+	templateHandler := templatehdl.New(templateService)
+	checklistHandler := checklisthdl.New(checklistService)
+	projectHandler := projecthdl.New(projectService)
+	customFieldHandler := customfieldhdl.New(customFieldService)
+	userHandler := userhdl.New(userService)
+	slaHandler := slahdl.New(slaService)
+	handoffHandler := handoffhdl.New(handoffService)
+	reviewHandler := reviewhdl.New(reviewService)
+	linkHandler := linkhdl.New(linkService)
+	commentHandler := commenthdl.New(commentService)
+	poolHandler := poolhdl.New(poolService)
+	notificationHandler := notificationhdl.New(notificationService)
+	watcherHandler := watcherhdl.New(watcherService)
+	sprintHandler := sprinthdl.New(sprintService)
+	wipHandler := wiphdl.New(wipService)
+	availabilityHandler := availabilityhdl.New(availabilityService)
+	taskGrpcServer := grpchdl.NewTaskServer(taskService)
+	authGrpcServer := grpchdl.NewAuthServer(authService)
 
 	// init server
 	engine := server.InitServer()
@@ -60,19 +201,63 @@ func main() {
 
 	// init router
 	route := router.HandlerList{
-		TaskHandler: taskHandler,
-		AuthHandler: authHandler,
+		TaskHandler:         taskHandler,
+		AuthHandler:         authHandler,
+		GraphqlHandler:      graphqlHandler,
+		ViewHandler:         viewHandler,
+		TemplateHandler:     templateHandler,
+		ChecklistHandler:    checklistHandler,
+		ProjectHandler:      projectHandler,
+		CustomFieldHandler:  customFieldHandler,
+		UserHandler:         userHandler,
+		SLAHandler:          slaHandler,
+		HandoffHandler:      handoffHandler,
+		ReviewHandler:       reviewHandler,
+		LinkHandler:         linkHandler,
+		CommentHandler:      commentHandler,
+		PoolHandler:         poolHandler,
+		NotificationHandler: notificationHandler,
+		WatcherHandler:      watcherHandler,
+		SprintHandler:       sprintHandler,
+		WIPHandler:          wipHandler,
+		AvailabilityHandler: availabilityHandler,
 	}
 
 	router.InitRouter(engine, route)
 
+	// init grpc server
+	grpcServer := server.InitGrpcServer(
+		grpc.ChainUnaryInterceptor(
+			middleware.UnaryRequestLogger(ctx),
+			middleware.UnaryAuthInterceptor(router.GrpcMethodAccess()),
+		),
+	)
+
+	// init grpc router
+	grpcRoute := router.GrpcHandlerList{
+		TaskServer: taskGrpcServer,
+		AuthServer: authGrpcServer,
+	}
+
+	router.InitGrpcRouter(grpcServer, grpcRoute)
+
 	// serverHost := property.Get().Server.Host
 	serverPort := property.Get().Server.Port
+	grpcPort := property.Get().Server.GrpcPort
 
 	gracefulStop := make(chan os.Signal, 1)
 	signal.Notify(gracefulStop, syscall.SIGINT, syscall.SIGTERM)
 
-	server.StartServerWithCtx(ctx, engine, "", serverPort)
+	// run the workers in the background until shutdown, started before the server blocks
+	go worker.Run(ctx, "SLA check", property.Get().Server.SLACheckInterval, slaService.CheckSLAs)
+	go worker.Run(ctx, "Notification check", property.Get().Server.NotificationInterval, notificationService.CheckNotifications)
+	if mailer != nil {
+		go worker.Run(ctx, "Email", property.Get().Server.EmailSendInterval, notificationService.SendEmails)
+	} else {
+		log.Infof(ctx, "SMTP_HOST not set, notifications are not emailed")
+	}
+
+	server.StartServerWithCtx(ctx, engine, grpcServer, "", serverPort, grpcPort)
 
 	// Wait for a termination signal
 	sig := <-gracefulStop
@@ -0,0 +1,114 @@
+// Package assignment ranks employees for a task from their current workload
+// and how well their skills match the task labels.
+package assignment
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"kn-assignment/internal/core/domain"
+)
+
+const (
+	// overdueWeight counts an overdue task as this many open tasks
+	overdueWeight = 2
+	// hoursPerTask converts estimated hours into a number of open tasks
+	hoursPerTask = 8
+	// skillWeight is the score of matching all task labels
+	skillWeight = 10
+)
+
+// Load is the workload of an employee expressed in open tasks
+func Load(w domain.Workload) float64 {
+	return float64(w.OpenTasks) + overdueWeight*float64(w.OverdueTasks) + w.EstimatedHours/hoursPerTask
+}
+
+// MatchingSkills returns the skills of the employee that are labels of the task
+func MatchingSkills(w domain.Workload, labels []string) []string {
+	wanted := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		wanted[label] = true
+	}
+	matching := []string{}
+	for _, skill := range w.Skills {
+		if wanted[skill] {
+			matching = append(matching, skill)
+		}
+	}
+	return matching
+}
+
+// Suggest ranks employees for a task with the given labels, best first.
+// Matching every label is worth skillWeight open tasks.
+func Suggest(workloads []domain.Workload, labels []string) []domain.AssigneeSuggestion {
+	suggestions := make([]domain.AssigneeSuggestion, 0, len(workloads))
+	for _, w := range workloads {
+		matching := MatchingSkills(w, labels)
+		score := -Load(w)
+		if len(labels) > 0 {
+			score += skillWeight * float64(len(matching)) / float64(len(labels))
+		}
+		suggestions = append(suggestions, domain.AssigneeSuggestion{
+			Workload:       w,
+			MatchingSkills: matching,
+			Score:          math.Round(score*100) / 100,
+		})
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Username < suggestions[j].Username
+	})
+	return suggestions
+}
+
+// Pick returns the employee the strategy assigns a task with the given labels to.
+// It returns false when there is no employee.
+func Pick(strategy domain.AssignStrategy, workloads []domain.Workload, labels []string) (domain.Workload, bool) {
+	if len(workloads) == 0 {
+		return domain.Workload{}, false
+	}
+	candidates := append([]domain.Workload(nil), workloads...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if strategy == domain.StrategySkillBased {
+			if ma, mb := len(MatchingSkills(a, labels)), len(MatchingSkills(b, labels)); ma != mb {
+				return ma > mb
+			}
+		}
+		if strategy != domain.StrategyRoundRobin {
+			if la, lb := Load(a), Load(b); la != lb {
+				return la < lb
+			}
+		}
+		return assignedBefore(a, b)
+	})
+	return candidates[0], true
+}
+
+// assignedBefore orders employees never assigned first, then by last assignment and username
+func assignedBefore(a, b domain.Workload) bool {
+	switch {
+	case a.LastAssignedAt == nil && b.LastAssignedAt != nil:
+		return true
+	case a.LastAssignedAt != nil && b.LastAssignedAt == nil:
+		return false
+	case a.LastAssignedAt != nil && !a.LastAssignedAt.Equal(*b.LastAssignedAt):
+		return a.LastAssignedAt.Before(*b.LastAssignedAt)
+	default:
+		return a.Username < b.Username
+	}
+}
+
+// Without returns the workloads of the employees other than the given ones
+func Without(workloads []domain.Workload, userIDs ...string) []domain.Workload {
+	left := make([]domain.Workload, 0, len(workloads))
+	for _, w := range workloads {
+		if !slices.Contains(userIDs, w.UserID) {
+			left = append(left, w)
+		}
+	}
+	return left
+}
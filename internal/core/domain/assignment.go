@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// AssignStrategy picks the assignee of auto-assigned tasks
+type AssignStrategy string
+
+const (
+	// StrategyRoundRobin picks the employee assigned least recently
+	StrategyRoundRobin AssignStrategy = "round_robin"
+	// StrategyLeastLoaded picks the employee with the lowest workload
+	StrategyLeastLoaded AssignStrategy = "least_loaded"
+	// StrategySkillBased picks the employee whose skills match the most task labels, then the least loaded
+	StrategySkillBased AssignStrategy = "skill_based"
+)
+
+func (s AssignStrategy) IsValid() bool {
+	switch s {
+	case StrategyRoundRobin, StrategyLeastLoaded, StrategySkillBased:
+		return true
+	default:
+		return false
+	}
+}
+
+// Workload is the current load of an employee. Only tasks that are not completed count.
+type Workload struct {
+	UserID         string     `json:"user_id"`
+	Username       string     `json:"username"`
+	Skills         []string   `json:"skills"`
+	LastAssignedAt *time.Time `json:"last_assigned_at"`
+	OpenTasks      int        `json:"open_tasks"`
+	OverdueTasks   int        `json:"overdue_tasks"`
+	EstimatedHours float64    `json:"estimated_hours"`
+	// InProgressTasks count against the WIP limit of the employee, their own WIPLimit when set
+	InProgressTasks int  `json:"in_progress_tasks"`
+	WIPLimit        *int `json:"-"`
+}
+
+// AssigneeSuggestion is an employee ranked for a task, best first
+type AssigneeSuggestion struct {
+	Workload
+	MatchingSkills []string `json:"matching_skills"`
+	Score          float64  `json:"score"`
+}
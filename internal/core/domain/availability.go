@@ -0,0 +1,76 @@
+package domain
+
+import "time"
+
+// WorkingHours are the days a user works, ISO weekdays from 1 for Monday to 7 for Sunday,
+// and the hours they work on them, as HH:MM in their timezone
+type WorkingHours struct {
+	Days  []int  `json:"days"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// DefaultWorkingHours are the working hours of users who did not set theirs
+func DefaultWorkingHours() WorkingHours {
+	return WorkingHours{Days: []int{1, 2, 3, 4, 5}, Start: "09:00", End: "17:00"}
+}
+
+// TimeOff is a leave of a user between two days, both included, in their timezone
+type TimeOff struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	StartDate string    `json:"start_date"`
+	EndDate   string    `json:"end_date"`
+	Reason    string    `json:"reason"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreateTimeOffRequest struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Reason    string `json:"reason"`
+}
+
+// Holiday is a public holiday of the organization, off for everyone
+type Holiday struct {
+	Date      string    `json:"date"`
+	Name      string    `json:"name"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreateHolidayRequest struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// WorkCalendar holds what decides the working days of a user
+type WorkCalendar struct {
+	Timezone     string
+	WorkingHours WorkingHours
+	Holidays     []Holiday
+	TimeOff      []TimeOff
+}
+
+// Availability lists the days of a range in the timezone of a user, with whether they work on them
+type Availability struct {
+	UserID       string            `json:"user_id"`
+	Timezone     string            `json:"timezone"`
+	WorkingHours WorkingHours      `json:"working_hours"`
+	From         string            `json:"from"`
+	To           string            `json:"to"`
+	Days         []AvailabilityDay `json:"days"`
+	// TimeOff lists the time off of the user overlapping the range
+	TimeOff []TimeOff `json:"time_off"`
+}
+
+type AvailabilityDay struct {
+	Date    string `json:"date"`
+	Working bool   `json:"working"`
+	// Reason tells why the user does not work on the day
+	Reason string `json:"reason,omitempty"`
+	// Start and End are the working hours of the day
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// DefaultTimezone is the timezone of users who did not set one
+const DefaultTimezone = "UTC"
+
+// LoadTimezone returns the location of an IANA timezone name, UTC when empty
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimezone
+	}
+	return time.LoadLocation(name)
+}
+
+// TaskCalendarRequest selects the tasks due between two local days, both included.
+// Timezone defaults to the timezone of the user.
+type TaskCalendarRequest struct {
+	From       string
+	To         string
+	Timezone   string
+	AssigneeID string
+	// CountsOnly leaves out the tasks of each day, e.g. for month views
+	CountsOnly bool
+}
+
+// TaskCalendar holds every day of a range with the tasks due on it in a timezone
+type TaskCalendar struct {
+	Timezone string        `json:"timezone"`
+	From     string        `json:"from"`
+	To       string        `json:"to"`
+	Total    int           `json:"total"`
+	Days     []CalendarDay `json:"days"`
+}
+
+type CalendarDay struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+	Tasks []Task `json:"tasks,omitempty"`
+}
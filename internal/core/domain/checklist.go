@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ChecklistItem is a small step of a task. Items are ordered by Position, a
+// fractional rank as used for the board.
+type ChecklistItem struct {
+	ID        string     `json:"id"`
+	TaskID    string     `json:"task_id"`
+	Content   string     `json:"content"`
+	Required  bool       `json:"required"`
+	Done      bool       `json:"done"`
+	DoneBy    *string    `json:"done_by"`
+	DoneAt    *time.Time `json:"done_at"`
+	Position  string     `json:"position"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type CreateChecklistItemRequest struct {
+	Content  string `json:"content"`
+	Required bool   `json:"required"`
+	// Position is set by the service to append the item to the checklist
+	Position string `json:"-"`
+}
+
+// UpdateChecklistItemRequest holds the fields of an item to update. Nil fields are left unchanged.
+type UpdateChecklistItemRequest struct {
+	Content  *string `json:"content"`
+	Required *bool   `json:"required"`
+}
+
+// MoveChecklistItemRequest places an item between two other items of the checklist.
+// An empty PreviousItemID moves the item to the top, an empty NextItemID to the bottom.
+type MoveChecklistItemRequest struct {
+	PreviousItemID string `json:"previous_item_id"`
+	NextItemID     string `json:"next_item_id"`
+}
+
+// NormalizeChecklist trims checklist items and drops empty ones
+func NormalizeChecklist(items []string) []string {
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
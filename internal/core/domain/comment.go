@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// TaskComment is a Markdown comment on a task. Its mentions notify the mentioned
+// users and its references to other tasks link them to the task.
+type TaskComment struct {
+	ID       string `json:"id"`
+	TaskID   string `json:"task_id"`
+	AuthorID string `json:"author_id"`
+	Username string `json:"username"`
+	Body     string `json:"body"`
+	// BodyHTML is the body rendered to sanitized HTML
+	BodyHTML  string    `json:"body_html"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TaskCommentRequest struct {
+	Body string `json:"body"`
+}
@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+type CustomFieldType string
+
+const (
+	CustomFieldText      CustomFieldType = "text"
+	CustomFieldNumber    CustomFieldType = "number"
+	CustomFieldDate      CustomFieldType = "date"
+	CustomFieldEnum      CustomFieldType = "enum"
+	CustomFieldMultiEnum CustomFieldType = "multi_enum"
+	CustomFieldUser      CustomFieldType = "user"
+)
+
+func (t CustomFieldType) IsValid() bool {
+	switch t {
+	case CustomFieldText, CustomFieldNumber, CustomFieldDate, CustomFieldEnum, CustomFieldMultiEnum, CustomFieldUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsOrdered reports whether values of the type can be compared with < and >
+func (t CustomFieldType) IsOrdered() bool {
+	return t == CustomFieldNumber || t == CustomFieldDate
+}
+
+// CustomField defines a custom field of tasks. Fields without a project apply
+// to the tasks of every project. Values are stored on tasks under Key: text,
+// enum and user fields as strings, number fields as numbers, date fields as
+// YYYY-MM-DD strings and multi_enum fields as arrays of strings.
+type CustomField struct {
+	ID        string          `json:"id"`
+	Key       string          `json:"key"`
+	Name      string          `json:"name"`
+	Type      CustomFieldType `json:"type"`
+	Options   []string        `json:"options"`
+	Required  bool            `json:"required"`
+	ProjectID *string         `json:"project_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// AppliesTo reports whether the field is defined for tasks of the project
+func (f CustomField) AppliesTo(projectID *string) bool {
+	return f.ProjectID == nil || (projectID != nil && *f.ProjectID == *projectID)
+}
+
+type CreateCustomFieldRequest struct {
+	Key       string          `json:"key"`
+	Name      string          `json:"name"`
+	Type      CustomFieldType `json:"type"`
+	Options   []string        `json:"options"`
+	Required  bool            `json:"required"`
+	ProjectID *string         `json:"project_id"`
+}
+
+// UpdateCustomFieldRequest holds the fields of a definition to update. Nil fields are left unchanged.
+// The key, type and project of a field cannot change.
+type UpdateCustomFieldRequest struct {
+	Name     *string   `json:"name"`
+	Options  *[]string `json:"options"`
+	Required *bool     `json:"required"`
+}
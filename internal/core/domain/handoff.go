@@ -0,0 +1,62 @@
+package domain
+
+import "time"
+
+type HandoffType string
+
+const (
+	// HandoffReassign asks for the task to be given to another employee
+	HandoffReassign HandoffType = "reassign"
+	// HandoffDecline gives the task back, leaving it unassigned
+	HandoffDecline HandoffType = "decline"
+)
+
+func (t HandoffType) IsValid() bool {
+	return t == HandoffReassign || t == HandoffDecline
+}
+
+type HandoffStatus string
+
+const (
+	HandoffPending  HandoffStatus = "pending"
+	HandoffApproved HandoffStatus = "approved"
+	HandoffRejected HandoffStatus = "rejected"
+)
+
+func (s HandoffStatus) IsValid() bool {
+	return s == HandoffPending || s == HandoffApproved || s == HandoffRejected
+}
+
+// HandoffRequest is the request of an assignee to be relieved of a task, resolved by an employer
+type HandoffRequest struct {
+	ID     string      `json:"id"`
+	TaskID string      `json:"task_id"`
+	Type   HandoffType `json:"type"`
+	Reason string      `json:"reason"`
+	// SuggestedAssigneeID is the employee the requester proposes to take over a reassigned task
+	SuggestedAssigneeID *string       `json:"suggested_assignee_id"`
+	Status              HandoffStatus `json:"status"`
+	RequestedBy         string        `json:"requested_by"`
+	ResolvedBy          *string       `json:"resolved_by"`
+	ResolutionNote      *string       `json:"resolution_note"`
+	ResolvedAt          *time.Time    `json:"resolved_at"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+	// TaskTitle is filled when listing requests
+	TaskTitle string `json:"task_title,omitempty"`
+}
+
+type CreateHandoffRequest struct {
+	Type                HandoffType `json:"type"`
+	Reason              string      `json:"reason"`
+	SuggestedAssigneeID *string     `json:"suggested_assignee_id"`
+}
+
+// ResolveHandoffRequest approves or rejects a request. AssigneeID picks who takes
+// over an approved reassignment, the suggested assignee by default.
+type ResolveHandoffRequest struct {
+	AssigneeID   *string `json:"assignee_id"`
+	Note         string  `json:"note"`
+	OverrideNote string  `json:"override_note"`
+	AllowTimeOff bool    `json:"allow_time_off"`
+}
@@ -0,0 +1,83 @@
+package domain
+
+import "time"
+
+// TaskLinkType is the relation of the source task of a link to its target
+type TaskLinkType string
+
+const (
+	LinkDuplicateOf TaskLinkType = "duplicate_of"
+	LinkRelatesTo   TaskLinkType = "relates_to"
+	LinkFollowUpOf  TaskLinkType = "follow_up_of"
+	LinkCausedBy    TaskLinkType = "caused_by"
+	// LinkBlockedBy makes the source task depend on its target, which must finish first
+	LinkBlockedBy TaskLinkType = "blocked_by"
+)
+
+// taskLinkLabels reads a link from its source task, then from its target task
+var taskLinkLabels = map[TaskLinkType][2]string{
+	LinkDuplicateOf: {"duplicate of", "duplicated by"},
+	LinkRelatesTo:   {"relates to", "relates to"},
+	LinkFollowUpOf:  {"follow-up of", "followed up by"},
+	LinkCausedBy:    {"caused by", "causes"},
+	LinkBlockedBy:   {"blocked by", "blocks"},
+}
+
+func (t TaskLinkType) IsValid() bool {
+	_, ok := taskLinkLabels[t]
+	return ok
+}
+
+func (t TaskLinkType) Label() string {
+	return taskLinkLabels[t][0]
+}
+
+func (t TaskLinkType) ReverseLabel() string {
+	return taskLinkLabels[t][1]
+}
+
+type TaskLink struct {
+	ID           string       `json:"id"`
+	SourceTaskID string       `json:"source_task_id"`
+	TargetTaskID string       `json:"target_task_id"`
+	Type         TaskLinkType `json:"type"`
+	CreatedBy    string       `json:"created_by"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+// LinkedTask is a link seen from one of its tasks, with the label reading from that task
+// to the other one, e.g. "duplicated by" for the target of a duplicate_of link.
+type LinkedTask struct {
+	// FromTaskID is the task the link is seen from
+	FromTaskID string       `json:"-"`
+	LinkID     string       `json:"link_id"`
+	Type       TaskLinkType `json:"type"`
+	// Outgoing is set when the task the link is seen from is its source
+	Outgoing  bool       `json:"outgoing"`
+	Label     string     `json:"label"`
+	TaskID    string     `json:"task_id"`
+	Title     string     `json:"title"`
+	Status    TaskStatus `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	// AssigneeID of the linked task decides whether the caller may read it
+	AssigneeID *string `json:"-"`
+}
+
+// SetLabel fills the label of the link as read from the task it is seen from
+func (l *LinkedTask) SetLabel() {
+	if l.Outgoing {
+		l.Label = l.Type.Label()
+	} else {
+		l.Label = l.Type.ReverseLabel()
+	}
+}
+
+type CreateTaskLinkRequest struct {
+	TargetTaskID string       `json:"target_task_id"`
+	Type         TaskLinkType `json:"type"`
+}
+
+// CloseAsDuplicateRequest completes a task as a duplicate of the original task
+type CloseAsDuplicateRequest struct {
+	OriginalTaskID string `json:"original_task_id"`
+}
@@ -0,0 +1,122 @@
+package domain
+
+import "time"
+
+type NotificationType string
+
+const (
+	NotificationAssigned      NotificationType = "assigned"
+	NotificationStatusChanged NotificationType = "status_changed"
+	NotificationTaskUpdated   NotificationType = "task_updated"
+	NotificationMention       NotificationType = "mention"
+	NotificationComment       NotificationType = "comment"
+	NotificationDueSoon       NotificationType = "due_soon"
+	NotificationOverdue       NotificationType = "overdue"
+	NotificationReviewed      NotificationType = "reviewed"
+	NotificationSLAEscalated  NotificationType = "sla_escalated"
+	// NotificationDigest is the daily email of open and overdue tasks, it has no in-app notification
+	NotificationDigest NotificationType = "digest"
+)
+
+// NotificationTypes are the types users can set preferences for
+var NotificationTypes = []NotificationType{
+	NotificationAssigned, NotificationStatusChanged, NotificationTaskUpdated, NotificationMention, NotificationComment, NotificationDueSoon,
+	NotificationOverdue, NotificationReviewed, NotificationSLAEscalated, NotificationDigest,
+}
+
+// EmailedByDefault are the types sent by email to users who have not set a preference for them
+var EmailedByDefault = []NotificationType{NotificationAssigned, NotificationOverdue, NotificationReviewed, NotificationDigest}
+
+func (t NotificationType) IsValid() bool {
+	for _, notificationType := range NotificationTypes {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+type EmailStatus string
+
+const (
+	EmailPending EmailStatus = "pending"
+	EmailSent    EmailStatus = "sent"
+	EmailFailed  EmailStatus = "failed"
+)
+
+// Notification tells a user about a change, usually of a task, made by ActorID if anyone
+type Notification struct {
+	ID        string           `json:"id"`
+	UserID    string           `json:"user_id"`
+	Type      NotificationType `json:"type"`
+	TaskID    *string          `json:"task_id"`
+	ActorID   *string          `json:"actor_id"`
+	Message   string           `json:"message"`
+	ReadAt    *time.Time       `json:"read_at"`
+	CreatedAt time.Time        `json:"created_at"`
+	// InApp is false for notifications the user only wants by email
+	InApp bool `json:"-"`
+	// EmailStatus is nil when the notification is not emailed
+	EmailStatus   *EmailStatus `json:"email_status"`
+	EmailAttempts int          `json:"-"`
+	EmailedAt     *time.Time   `json:"-"`
+}
+
+type CreateNotificationRequest struct {
+	UserID string
+	// Watchers sends the notification to the watchers of TaskID rather than to UserID
+	Watchers bool
+	Type     NotificationType
+	TaskID   *string
+	ActorID  *string
+	Message  string
+}
+
+// NotificationInbox holds the latest notifications of a user and how many are unread in total
+type NotificationInbox struct {
+	UnreadCount   int            `json:"unread_count"`
+	Notifications []Notification `json:"notifications"`
+}
+
+// NotificationPreference sets the channels a type of notification is delivered through
+type NotificationPreference struct {
+	Type  NotificationType `json:"type"`
+	InApp bool             `json:"in_app"`
+	Email bool             `json:"email"`
+}
+
+// DefaultNotificationPreference is the preference of users who have not set one for a type
+func DefaultNotificationPreference(t NotificationType) NotificationPreference {
+	preference := NotificationPreference{Type: t, InApp: t != NotificationDigest}
+	for _, emailed := range EmailedByDefault {
+		if t == emailed {
+			preference.Email = true
+		}
+	}
+	return preference
+}
+
+// NotificationEmail is a pending email of a notification with what its templates show
+type NotificationEmail struct {
+	Notification
+	Email     string  `json:"email"`
+	Username  string  `json:"username"`
+	TaskTitle *string `json:"task_title"`
+}
+
+// Email is a message sent to a single recipient with plain text and HTML bodies
+type Email struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Digest lists the open tasks of an employee on a day, due dates read in their timezone
+type Digest struct {
+	Username string
+	Date     string
+	Overdue  []Task
+	// Open are the open tasks not overdue yet
+	Open []Task
+}
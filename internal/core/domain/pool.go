@@ -0,0 +1,6 @@
+package domain
+
+// ClaimTaskRequest assigns a pool task to the employee claiming it
+type ClaimTaskRequest struct {
+	AllowTimeOff bool `json:"allow_time_off"`
+}
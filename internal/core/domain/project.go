@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// Project groups tasks. Key is a short uppercase code such as OPS.
+type Project struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	// ReviewRequired sends completed tasks of the project to review, unless a task says otherwise
+	ReviewRequired bool      `json:"review_required"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type CreateProjectRequest struct {
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	ReviewRequired bool   `json:"review_required"`
+}
+
+// UpdateProjectRequest holds the fields of a project to update. Nil fields are left unchanged.
+type UpdateProjectRequest struct {
+	Name           *string `json:"name"`
+	ReviewRequired *bool   `json:"review_required"`
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// TaskReview is the outcome of the review of a completed task by its creator
+type TaskReview struct {
+	ID         string `json:"id"`
+	TaskID     string `json:"task_id"`
+	ReviewerID string `json:"reviewer_id"`
+	// SubmittedBy is the assignee who completed the task
+	SubmittedBy *string   `json:"submitted_by"`
+	Approved    bool      `json:"approved"`
+	Feedback    string    `json:"feedback"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ReviewTaskRequest accepts a task in review or sends it back with feedback
+type ReviewTaskRequest struct {
+	Approved bool   `json:"approved"`
+	Feedback string `json:"feedback"`
+	// OverrideNote sends a task back past the WIP limits, explaining why
+	OverrideNote string `json:"override_note"`
+}
@@ -0,0 +1,102 @@
+package domain
+
+import "time"
+
+// SLAStart is the event starting the SLA clocks of a task
+type SLAStart string
+
+const (
+	SLAStartCreated  SLAStart = "created"
+	SLAStartAssigned SLAStart = "assigned"
+)
+
+func (s SLAStart) IsValid() bool {
+	return s == SLAStartCreated || s == SLAStartAssigned
+}
+
+// SLAEscalation is what happens to a task about to breach its SLA
+type SLAEscalation string
+
+const (
+	// EscalationNotify notifies the employer who created the task
+	EscalationNotify SLAEscalation = "notify"
+	// EscalationReassign reassigns the task to the least loaded other employee
+	EscalationReassign SLAEscalation = "reassign"
+)
+
+func (e SLAEscalation) IsValid() bool {
+	return e == EscalationNotify || e == EscalationReassign
+}
+
+// SLAPolicy sets response and resolution targets for matching tasks. A policy
+// matches a task having any of its labels, its priority and its project; empty
+// criteria match every task. A task follows the first matching policy by precedence.
+type SLAPolicy struct {
+	ID        string        `json:"id"`
+	Name      string        `json:"name"`
+	Labels    []string      `json:"labels"`
+	Priority  *TaskPriority `json:"priority"`
+	ProjectID *string       `json:"project_id"`
+	// Precedence orders matching policies, lowest first
+	Precedence int      `json:"precedence"`
+	StartOn    SLAStart `json:"start_on"`
+	// ResponseMinutes is the time to move the task out of Pending, nil for no target
+	ResponseMinutes *int `json:"response_minutes"`
+	// ResolutionMinutes is the time to complete the task, nil for no target
+	ResolutionMinutes *int `json:"resolution_minutes"`
+	// WarnBeforeMinutes escalates tasks this long before a target is breached
+	WarnBeforeMinutes int           `json:"warn_before_minutes"`
+	Escalation        SLAEscalation `json:"escalation"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+type CreateSLAPolicyRequest struct {
+	Name              string        `json:"name"`
+	Labels            []string      `json:"labels"`
+	Priority          *TaskPriority `json:"priority"`
+	ProjectID         *string       `json:"project_id"`
+	Precedence        int           `json:"precedence"`
+	StartOn           SLAStart      `json:"start_on"`
+	ResponseMinutes   *int          `json:"response_minutes"`
+	ResolutionMinutes *int          `json:"resolution_minutes"`
+	WarnBeforeMinutes int           `json:"warn_before_minutes"`
+	Escalation        SLAEscalation `json:"escalation"`
+}
+
+// UpdateSLAPolicyRequest holds the fields of a policy to update. Nil fields are left unchanged.
+// Targets only change the deadlines of tasks whose SLA starts afterwards.
+type UpdateSLAPolicyRequest struct {
+	Name              *string        `json:"name"`
+	Precedence        *int           `json:"precedence"`
+	ResponseMinutes   *int           `json:"response_minutes"`
+	ResolutionMinutes *int           `json:"resolution_minutes"`
+	WarnBeforeMinutes *int           `json:"warn_before_minutes"`
+	Escalation        *SLAEscalation `json:"escalation"`
+}
+
+// TaskSLA is the SLA clock of a task. Due dates move forward by the time the task spends Blocked.
+type TaskSLA struct {
+	TaskID             string     `json:"task_id"`
+	PolicyID           *string    `json:"policy_id"`
+	StartedAt          time.Time  `json:"started_at"`
+	ResponseDueAt      *time.Time `json:"response_due_at"`
+	ResolutionDueAt    *time.Time `json:"resolution_due_at"`
+	RespondedAt        *time.Time `json:"responded_at"`
+	ResolvedAt         *time.Time `json:"resolved_at"`
+	PausedAt           *time.Time `json:"paused_at"`
+	ResponseBreached   bool       `json:"response_breached"`
+	ResolutionBreached bool       `json:"resolution_breached"`
+	EscalatedAt        *time.Time `json:"escalated_at"`
+	EscalatedTo        *string    `json:"escalated_to"`
+}
+
+// SLAEscalationCandidate is an open task SLA within the warning time of its policy
+type SLAEscalationCandidate struct {
+	TaskSLA
+	Escalation SLAEscalation `json:"escalation"`
+	TaskTitle  string        `json:"task_title"`
+	AssigneeID *string       `json:"assignee_id"`
+	Labels     []string      `json:"labels"`
+	CreatedBy  string        `json:"created_by"`
+}
@@ -0,0 +1,102 @@
+package domain
+
+import "time"
+
+type SprintStatus string
+
+const (
+	SprintPlanned SprintStatus = "planned"
+	SprintActive  SprintStatus = "active"
+	// SprintClosed sprints keep their completed tasks, their unfinished ones being carried over on close
+	SprintClosed SprintStatus = "closed"
+)
+
+func (s SprintStatus) IsValid() bool {
+	return s == SprintPlanned || s == SprintActive || s == SprintClosed
+}
+
+// Sprint time-boxes tasks between two days, both included, given as YYYY-MM-DD dates
+type Sprint struct {
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	Goal      string       `json:"goal"`
+	StartDate string       `json:"start_date"`
+	EndDate   string       `json:"end_date"`
+	Status    SprintStatus `json:"status"`
+	// TaskCount tasks of StoryPoints points are in the sprint, of which CompletedTasks of CompletedPoints points are completed
+	TaskCount       int        `json:"task_count"`
+	StoryPoints     float64    `json:"story_points"`
+	CompletedTasks  int        `json:"completed_tasks"`
+	CompletedPoints float64    `json:"completed_points"`
+	StartedAt       *time.Time `json:"started_at"`
+	ClosedAt        *time.Time `json:"closed_at"`
+	ClosedBy        *string    `json:"closed_by"`
+	CreatedBy       *string    `json:"created_by"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+type CreateSprintRequest struct {
+	Name      string `json:"name"`
+	Goal      string `json:"goal"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// UpdateSprintRequest holds the fields of a sprint to update. Nil fields are left unchanged.
+type UpdateSprintRequest struct {
+	Name      *string `json:"name"`
+	Goal      *string `json:"goal"`
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+}
+
+// CloseSprintRequest closes a sprint. Its unfinished tasks are carried over to
+// the sprint CarryOverSprintID, or go back to the backlog when it is nil.
+type CloseSprintRequest struct {
+	CarryOverSprintID *string `json:"carry_over_sprint_id"`
+}
+
+type CloseSprintResult struct {
+	Sprint Sprint `json:"sprint"`
+	// CarriedOverTaskIDs are the unfinished tasks moved out of the sprint
+	CarriedOverTaskIDs []string `json:"carried_over_task_ids"`
+}
+
+// SprintScopeChange records a task added to or removed from a sprint
+type SprintScopeChange struct {
+	TaskID    string    `json:"task_id"`
+	Added     bool      `json:"added"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// TaskStatusChange records a change of the status of a task. FromStatus is nil for
+// the statuses tasks had before their history was kept.
+type TaskStatusChange struct {
+	TaskID     string      `json:"task_id"`
+	FromStatus *TaskStatus `json:"from_status"`
+	ToStatus   TaskStatus  `json:"to_status"`
+	ChangedBy  *string     `json:"changed_by"`
+	ChangedAt  time.Time   `json:"changed_at"`
+}
+
+// SprintBurndown holds every day of a sprint with its scope and completed work at the
+// end of the day in a timezone, for both burndown and burnup charts
+type SprintBurndown struct {
+	SprintID string        `json:"sprint_id"`
+	Timezone string        `json:"timezone"`
+	Days     []BurndownDay `json:"days"`
+}
+
+// BurndownDay holds the scope and completed work of a sprint at the end of a day, or at the
+// time of the request or of the close of the sprint when earlier. They are nil for the days
+// to come. IdealRemainingPoints burns the scope of the start of the sprint down to zero evenly.
+type BurndownDay struct {
+	Date                 string   `json:"date"`
+	ScopeTasks           *int     `json:"scope_tasks"`
+	ScopePoints          *float64 `json:"scope_points"`
+	CompletedTasks       *int     `json:"completed_tasks"`
+	CompletedPoints      *float64 `json:"completed_points"`
+	RemainingPoints      *float64 `json:"remaining_points"`
+	IdealRemainingPoints float64  `json:"ideal_remaining_points"`
+}
@@ -1,36 +1,238 @@
 package domain
 
-import "time"
+import (
+	"regexp"
+	"strings"
+	"time"
+)
 
 type TaskStatus string
 
 const (
 	StatusPending    TaskStatus = "Pending"
 	StatusInProgress TaskStatus = "In Progress"
-	StatusCompleted  TaskStatus = "Completed"
+	// StatusBlocked pauses the SLA clocks of a task
+	StatusBlocked TaskStatus = "Blocked"
+	// StatusInReview holds completed tasks until their creator reviews them
+	StatusInReview  TaskStatus = "In Review"
+	StatusCompleted TaskStatus = "Completed"
+)
+
+func (s TaskStatus) IsValid() bool {
+	switch s {
+	case StatusPending, StatusInProgress, StatusBlocked, StatusInReview, StatusCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	keyPrefixPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+	taskKeyPattern   = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]{1,9}-[0-9]+$`)
 )
 
+// IsValidKeyPrefix reports whether a project key or the default key prefix is 2 to 10
+// uppercase letters or digits, starting with a letter
+func IsValidKeyPrefix(prefix string) bool {
+	return keyPrefixPattern.MatchString(prefix)
+}
+
+// IsTaskKey reports whether a task reference is a key such as OPS-142, in any case, rather than an ID
+func IsTaskKey(ref string) bool {
+	return taskKeyPattern.MatchString(ref)
+}
+
+type TaskPriority string
+
+const (
+	PriorityLow    TaskPriority = "low"
+	PriorityMedium TaskPriority = "medium"
+	PriorityHigh   TaskPriority = "high"
+	PriorityUrgent TaskPriority = "urgent"
+)
+
+func (p TaskPriority) IsValid() bool {
+	switch p {
+	case PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent:
+		return true
+	default:
+		return false
+	}
+}
+
+// TaskSortFields are the columns tasks can be sorted by, besides custom fields as cf.<key>
+var TaskSortFields = map[string]bool{
+	"title":      true,
+	"status":     true,
+	"priority":   true,
+	"due_date":   true,
+	"created_at": true,
+	"updated_at": true,
+	"rank":       true,
+}
+
 type Task struct {
-	ID          string     `json:"id"`
+	ID string `json:"id"`
+	// Key is the sequential key of the task in its project, such as OPS-142. The keys it had in
+	// other projects remain aliases of the task.
+	Key         string `json:"key"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// DescriptionHTML is the Markdown description rendered to sanitized HTML
+	DescriptionHTML string     `json:"description_html"`
+	AssigneeID      *string    `json:"assignee_id"`
+	Status          TaskStatus `json:"status"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CreatedBy       string     `json:"created_by"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	UpdatedBy       string     `json:"updated_by"`
+	DueDate         time.Time  `json:"due_date"`
+	Labels          []string   `json:"labels"`
+	Rank            string     `json:"rank"`
+	// ChecklistDone of ChecklistTotal checklist items are done
+	ChecklistTotal int            `json:"checklist_total"`
+	ChecklistDone  int            `json:"checklist_done"`
+	ProjectID      *string        `json:"project_id"`
+	CustomFields   map[string]any `json:"custom_fields"`
+	EstimatedHours *float64       `json:"estimated_hours"`
+	Priority       TaskPriority   `json:"priority"`
+	// HandoffNote is the reason of the last approved handoff of the task
+	HandoffNote *string `json:"handoff_note"`
+	// ReviewRequired overrides the review requirement of the project when set
+	ReviewRequired *bool `json:"review_required"`
+	// Pooled tasks can be claimed by employees while unassigned. ClaimedAt is set while
+	// the assignee holds the task from a claim.
+	Pooled    bool       `json:"pooled"`
+	ClaimedAt *time.Time `json:"claimed_at"`
+	// SprintID is the sprint the task is planned in, nil for the backlog
+	SprintID    *string  `json:"sprint_id"`
+	StoryPoints *float64 `json:"story_points"`
+	// StartDate is when work on the task is planned to start. Milestones have none and
+	// mark their due date.
+	StartDate *time.Time `json:"start_date"`
+	Milestone bool       `json:"milestone"`
+}
+
+// RequiresReview reports whether completing the task needs a review, given its project if any
+func (t Task) RequiresReview(project *Project) bool {
+	if t.ReviewRequired != nil {
+		return *t.ReviewRequired
+	}
+	return project != nil && project.ReviewRequired
+}
+
+type CreateTaskRequest struct {
 	Title       string     `json:"title"`
 	Description string     `json:"description"`
-	AssigneeID  *string    `json:"assignee_id"`
-	Status      TaskStatus `json:"status"`
-	CreatedAt   time.Time  `json:"created_at"`
-	CreatedBy   string     `json:"created_by"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	UpdatedBy   string     `json:"updated_by"`
+	StartDate   *time.Time `json:"start_date"`
 	DueDate     time.Time  `json:"due_date"`
+	Milestone   bool       `json:"milestone"`
+	Labels      []string   `json:"labels"`
+	Checklist   []string   `json:"checklist"`
+	ProjectID   *string    `json:"project_id"`
+	// CustomFields holds values by custom field key
+	CustomFields   map[string]any `json:"custom_fields"`
+	EstimatedHours *float64       `json:"estimated_hours"`
+	StoryPoints    *float64       `json:"story_points"`
+	// Priority defaults to medium
+	Priority TaskPriority `json:"priority"`
+	// ReviewRequired overrides the review requirement of the project when set
+	ReviewRequired *bool `json:"review_required"`
+	// AutoAssign assigns the task to an employee picked by the configured strategy
+	AutoAssign bool `json:"auto_assign"`
+	// Rank is set by the service to append the task to its board column
+	Rank string `json:"-"`
+	// AssigneeID is set when tasks are created from a template or quick added
+	AssigneeID *string `json:"-"`
+	// AllowTimeOff gives the task to an assignee off on the day it is due
+	AllowTimeOff bool `json:"-"`
 }
 
-type CreateTaskRequest struct {
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	DueDate     time.Time `json:"due_date"`
+// UpdateTaskRequest holds the fields of a task to update. Nil fields are left unchanged.
+type UpdateTaskRequest struct {
+	Title       *string   `json:"title"`
+	Description *string   `json:"description"`
+	Labels      *[]string `json:"labels"`
+	ProjectID   *string   `json:"project_id"`
+	// CustomFields sets the given values, a nil value removes one
+	CustomFields   map[string]any `json:"custom_fields"`
+	EstimatedHours *float64       `json:"estimated_hours"`
+	StoryPoints    *float64       `json:"story_points"`
+	// StartDate is cleared when the task becomes a milestone
+	StartDate      *time.Time    `json:"start_date"`
+	Milestone      *bool         `json:"milestone"`
+	Priority       *TaskPriority `json:"priority"`
+	ReviewRequired *bool         `json:"review_required"`
+}
+
+// AssignTaskRequest assigns a task to an employee. OverrideNote lets an employer assign an In Progress
+// task past the WIP limit of the employee, and AllowTimeOff a task due during their time off.
+type AssignTaskRequest struct {
+	AssigneeID   string `json:"assignee_id"`
+	OverrideNote string `json:"override_note"`
+	AllowTimeOff bool   `json:"allow_time_off"`
+}
+
+// QuickAddRequest holds a task written as a line of text, such as
+// "Prepare Q3 report for @alice by next friday 5pm #finance !high"
+type QuickAddRequest struct {
+	Text string `json:"text"`
+	// Create creates the task, which is otherwise only read back for confirmation
+	Create bool `json:"create"`
+	// AllowTimeOff assigns the task even when it is due during the time off of the assignee
+	AllowTimeOff bool `json:"allow_time_off"`
+}
+
+// QuickAddResult is the task read from a line of text, with the created task when it was created
+type QuickAddResult struct {
+	Title      string       `json:"title"`
+	AssigneeID *string      `json:"assignee_id"`
+	Assignee   *string      `json:"assignee"`
+	DueDate    *time.Time   `json:"due_date"`
+	Labels     []string     `json:"labels"`
+	Priority   TaskPriority `json:"priority"`
+	Task       *Task        `json:"task,omitempty"`
+}
+
+// MoveTaskRequest places a task in a board column between two neighboring tasks.
+// An empty PreviousTaskID moves the task to the top of the column, an empty NextTaskID to the bottom.
+type MoveTaskRequest struct {
+	Status         TaskStatus `json:"status"`
+	PreviousTaskID string     `json:"previous_task_id"`
+	NextTaskID     string     `json:"next_task_id"`
+	// OverrideNote lets an employer move the task past a WIP limit, explaining why
+	OverrideNote string `json:"override_note"`
+}
+
+// NormalizeLabels lowercases and trims labels and drops empty and duplicate ones
+func NormalizeLabels(labels []string) []string {
+	out := make([]string, 0, len(labels))
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label == "" || seen[label] {
+			continue
+		}
+		seen[label] = true
+		out = append(out, label)
+	}
+	return out
 }
 
 type TaskSummary struct {
 	EmployeeID     string `json:"employee_id"`
 	TotalTasks     int    `json:"total_tasks"`
 	CompletedTasks int    `json:"completed_tasks"`
+	// SLATasks are the tasks under an SLA policy, of which some breached their targets
+	SLATasks           int `json:"sla_tasks"`
+	ResponseBreaches   int `json:"response_breaches"`
+	ResolutionBreaches int `json:"resolution_breaches"`
+	// ApprovedReviews and RejectedReviews count the review outcomes of the tasks the employee submitted
+	ApprovedReviews int `json:"approved_reviews"`
+	RejectedReviews int `json:"rejected_reviews"`
+	// InProgressTasks are over WIPLimit, the limit of the employee or nil for none, when OverWIPLimit
+	InProgressTasks int  `json:"in_progress_tasks"`
+	WIPLimit        *int `json:"wip_limit"`
+	OverWIPLimit    bool `json:"over_wip_limit"`
 }
@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+// TaskTemplate describes a repeatable task. Title, description and checklist
+// items may contain {{variable}} placeholders that are filled in when tasks are
+// created from the template. Tasks are due DueOffsetHours after their creation, or
+// at the end of the working day DueOffsetBusinessDays business days of their assignee ahead.
+type TaskTemplate struct {
+	ID                    string    `json:"id"`
+	Name                  string    `json:"name"`
+	Title                 string    `json:"title"`
+	Description           string    `json:"description"`
+	DueOffsetHours        *int      `json:"due_offset_hours"`
+	DueOffsetBusinessDays *int      `json:"due_offset_business_days"`
+	AssigneeID            *string   `json:"assignee_id"`
+	Labels                []string  `json:"labels"`
+	Checklist             []string  `json:"checklist"`
+	CreatedBy             string    `json:"created_by"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+	// Variables lists the placeholders used by the template
+	Variables []string `json:"variables" db:"-"`
+}
+
+type CreateTaskTemplateRequest struct {
+	Name                  string   `json:"name"`
+	Title                 string   `json:"title"`
+	Description           string   `json:"description"`
+	DueOffsetHours        *int     `json:"due_offset_hours"`
+	DueOffsetBusinessDays *int     `json:"due_offset_business_days"`
+	AssigneeID            *string  `json:"assignee_id"`
+	Labels                []string `json:"labels"`
+	Checklist             []string `json:"checklist"`
+}
+
+// UpdateTaskTemplateRequest holds the fields of a template to update. Nil fields are left unchanged,
+// and setting one due offset clears the other.
+type UpdateTaskTemplateRequest struct {
+	Name                  *string   `json:"name"`
+	Title                 *string   `json:"title"`
+	Description           *string   `json:"description"`
+	DueOffsetHours        *int      `json:"due_offset_hours"`
+	DueOffsetBusinessDays *int      `json:"due_offset_business_days"`
+	AssigneeID            *string   `json:"assignee_id"`
+	Labels                *[]string `json:"labels"`
+	Checklist             *[]string `json:"checklist"`
+}
+
+// TemplateInstance holds the values of one task created from a template.
+// AssigneeID and DueDate override the template defaults when set.
+type TemplateInstance struct {
+	Variables  map[string]string `json:"variables"`
+	AssigneeID *string           `json:"assignee_id"`
+	DueDate    *time.Time        `json:"due_date"`
+	// AllowTimeOff gives the task to an assignee off on the day it is due
+	AllowTimeOff bool `json:"allow_time_off"`
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// TaskTimeline holds tasks as bars from their start to their due date, with the dependencies
+// between them and their critical path, for Gantt charts
+type TaskTimeline struct {
+	Tasks        []TimelineTask       `json:"tasks"`
+	Dependencies []TimelineDependency `json:"dependencies"`
+	// CriticalPath are the IDs of the chain of critical tasks finishing last, in order
+	CriticalPath []string `json:"critical_path"`
+}
+
+// TimelineTask is a task planned from Start to Due. Tasks without a start date start their
+// estimated hours before their due date, and milestones and tasks without estimate are points.
+// The earliest and latest dates and the slack come from the critical path method.
+type TimelineTask struct {
+	ID             string     `json:"id"`
+	Key            string     `json:"key"`
+	Title          string     `json:"title"`
+	Status         TaskStatus `json:"status"`
+	AssigneeID     *string    `json:"assignee_id"`
+	Milestone      bool       `json:"milestone"`
+	Start          time.Time  `json:"start"`
+	Due            time.Time  `json:"due"`
+	EarliestStart  time.Time  `json:"earliest_start"`
+	EarliestFinish time.Time  `json:"earliest_finish"`
+	LatestStart    time.Time  `json:"latest_start"`
+	LatestFinish   time.Time  `json:"latest_finish"`
+	SlackHours     float64    `json:"slack_hours"`
+	Critical       bool       `json:"critical"`
+	// AtRisk is set when the task is due before it can finish given its blockers
+	AtRisk bool `json:"at_risk"`
+}
+
+// TimelineDependency is a blocked_by link between two tasks of a timeline
+type TimelineDependency struct {
+	LinkID        string `json:"link_id"`
+	TaskID        string `json:"task_id"`
+	BlockerTaskID string `json:"blocker_task_id"`
+}
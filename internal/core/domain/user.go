@@ -9,6 +9,19 @@ type User struct {
 	Role      Role      `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// Skills are matched against task labels when suggesting assignees
+	Skills         []string   `json:"skills"`
+	LastAssignedAt *time.Time `json:"last_assigned_at"`
+	// Timezone is the IANA timezone calendars and due date searches are read in
+	Timezone string `json:"timezone"`
+	// ClaimLimit overrides the default number of pool tasks the user can hold
+	ClaimLimit *int `json:"claim_limit"`
+	// WIPLimit overrides the default number of In Progress tasks the user can have
+	WIPLimit *int `json:"wip_limit"`
+	// Email is where notifications and digests are sent, nil for no emails
+	Email *string `json:"email"`
+	// DigestSentOn is the day, in the timezone of the user, the last digest was sent
+	DigestSentOn *time.Time `json:"-"`
 }
 
 type CreateUserRequest struct {
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+type ViewVisibility string
+
+const (
+	VisibilityPrivate ViewVisibility = "private"
+	VisibilityShared  ViewVisibility = "shared"
+)
+
+// TaskView is a saved combination of filters, search query, sort and columns for the task list
+type TaskView struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	OwnerID    string            `json:"owner_id"`
+	Visibility ViewVisibility    `json:"visibility"`
+	IsDefault  bool              `json:"is_default"`
+	Filters    map[string]string `json:"filters"`
+	Query      string            `json:"query"`
+	Sort       string            `json:"sort"`
+	SortOrder  string            `json:"sort_order"`
+	Columns    []string          `json:"columns"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+type CreateTaskViewRequest struct {
+	Name       string            `json:"name"`
+	Visibility ViewVisibility    `json:"visibility"`
+	IsDefault  bool              `json:"is_default"`
+	Filters    map[string]string `json:"filters"`
+	Query      string            `json:"query"`
+	Sort       string            `json:"sort"`
+	SortOrder  string            `json:"sort_order"`
+	Columns    []string          `json:"columns"`
+}
+
+// UpdateTaskViewRequest holds the fields of a view to update. Nil fields are left unchanged.
+type UpdateTaskViewRequest struct {
+	Name       *string            `json:"name"`
+	Visibility *ViewVisibility    `json:"visibility"`
+	IsDefault  *bool              `json:"is_default"`
+	Filters    *map[string]string `json:"filters"`
+	Query      *string            `json:"query"`
+	Sort       *string            `json:"sort"`
+	SortOrder  *string            `json:"sort_order"`
+	Columns    *[]string          `json:"columns"`
+}
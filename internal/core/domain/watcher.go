@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// TaskWatcher follows the changes of a task. Muted watchers get no notification about the task.
+type TaskWatcher struct {
+	TaskID    string    `json:"task_id"`
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	Muted     bool      `json:"muted"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WatchTaskRequest struct {
+	Muted bool `json:"muted"`
+}
@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// WIPLimits are the limits on work in progress: each employee has at most EmployeeLimit
+// tasks In Progress unless their own limit says otherwise, and each status column at
+// most the WIP limit of its status
+type WIPLimits struct {
+	// EmployeeLimit is the default number of In Progress tasks of an employee, 0 for no limit
+	EmployeeLimit int              `json:"employee_wip_limit"`
+	Statuses      []StatusWIPLimit `json:"statuses"`
+}
+
+// StatusWIPLimit is the limit of a status column and the tasks currently in it
+type StatusWIPLimit struct {
+	Status TaskStatus `json:"status"`
+	// WIPLimit is nil when the column has no limit
+	WIPLimit  *int       `json:"wip_limit"`
+	TaskCount int        `json:"task_count"`
+	UpdatedBy *string    `json:"updated_by"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// SetStatusWIPLimitRequest sets the limit of a status column, or removes it when WIPLimit is nil
+type SetStatusWIPLimitRequest struct {
+	Status   TaskStatus `json:"status"`
+	WIPLimit *int       `json:"wip_limit"`
+}
+
+type WIPLimitScope string
+
+const (
+	// WIPScopeEmployee limits the In Progress tasks of an employee
+	WIPScopeEmployee WIPLimitScope = "employee"
+	// WIPScopeStatus limits the tasks of a status column
+	WIPScopeStatus WIPLimitScope = "status"
+)
+
+// WIPOverride records an employer putting a task in a status past a WIP limit, which
+// already had TaskCount tasks, and why
+type WIPOverride struct {
+	ID     string        `json:"id"`
+	TaskID string        `json:"task_id"`
+	Scope  WIPLimitScope `json:"scope"`
+	Status TaskStatus    `json:"status"`
+	// EmployeeID is the employee whose limit was exceeded, nil for a status column
+	EmployeeID   *string   `json:"employee_id"`
+	WIPLimit     int       `json:"wip_limit"`
+	TaskCount    int       `json:"task_count"`
+	Note         string    `json:"note"`
+	OverriddenBy string    `json:"overridden_by"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// TaskChange is a task moving to a status or to an assignee, as checked against the WIP limits
+// and the time off of the assignee
+type TaskChange struct {
+	Task   Task
+	Status TaskStatus
+	// AssigneeID is the assignee of the task after the change, nil when unassigned
+	AssigneeID *string
+	// OverrideNote lets an employer go past the WIP limits
+	OverrideNote string
+	// AllowTimeOff gives the task to an assignee off on the day it is due
+	AllowTimeOff bool
+	// UserRole and UserID are who makes the change, empty for the system such as SLA escalations
+	UserRole string
+	UserID   string
+}
@@ -1,11 +1,14 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
 	"kn-assignment/internal/constant"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ErrorResponse represents a structured error response
@@ -54,6 +57,15 @@ func NewCustomErrorWithMessage(code constant.ErrorCode, message string) error {
 	}
 }
 
+// StatusCode returns the HTTP status for an error returned by a service
+func StatusCode(err error) int {
+	var customErr *CustomError
+	if stderrors.As(err, &customErr) {
+		return mapErrorCodeToHTTPStatus(customErr.Code)
+	}
+	return http.StatusInternalServerError
+}
+
 // mapErrorCodeToHTTPStatus maps custom error codes to HTTP status codes
 func mapErrorCodeToHTTPStatus(code constant.ErrorCode) int {
 	switch code {
@@ -73,3 +85,32 @@ func mapErrorCodeToHTTPStatus(code constant.ErrorCode) int {
 		return http.StatusInternalServerError
 	}
 }
+
+// GRPCError converts an error returned by a service into a gRPC status error
+func GRPCError(err error) error {
+	var customErr *CustomError
+	if stderrors.As(err, &customErr) {
+		return status.Error(mapErrorCodeToGRPCCode(customErr.Code), customErr.Message)
+	}
+	return status.Error(codes.Internal, constant.ErrMsgInternalServer)
+}
+
+// mapErrorCodeToGRPCCode maps custom error codes to gRPC status codes
+func mapErrorCodeToGRPCCode(code constant.ErrorCode) codes.Code {
+	switch code {
+	case constant.ErrCodeInvalidRequest:
+		return codes.InvalidArgument
+	case constant.ErrCodeUnauthorized, constant.ErrCodeInvalidCredential:
+		return codes.Unauthenticated
+	case constant.ErrCodeForbidden:
+		return codes.PermissionDenied
+	case constant.ErrCodeNotFound:
+		return codes.NotFound
+	case constant.ErrCodeConflict, constant.ErrCodeDuplicateUser:
+		return codes.AlreadyExists
+	case constant.ErrCodeInternalServer:
+		fallthrough
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,211 @@
+// Package markup reads the Markdown texts of tasks and comments: it parses the users they
+// mention with @username and the tasks they reference with #<task id> or #<task key>, and renders them to
+// sanitized HTML with the mentions and references marked up.
+//
+// Mentions and references are not parsed inside code blocks and code spans, nor right after
+// a word or within a URL, so that e-mail addresses and URL fragments are left alone.
+package markup
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/russross/blackfriday/v2"
+)
+
+var (
+	mentionPattern = regexp.MustCompile(`(^|[^\w/@#&.:-])@([A-Za-z0-9_](?:[A-Za-z0-9_.-]*[A-Za-z0-9_])?)`)
+	// referencePattern matches a task ID, or else an uppercase key so that e.g. #v2-1 is left alone
+	referencePattern = regexp.MustCompile(
+		`(^|[^\w/@#&.:-])#(?:([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})|([A-Z][A-Z0-9]{1,9}-[0-9]+))\b`)
+)
+
+// policy allows the HTML of user generated content and the markup of mentions and references
+var policy = func() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^(mention|task-ref)$`)).OnElements("span")
+	p.AllowAttrs("data-username", "data-task-id", "data-task-key").OnElements("span")
+	return p
+}()
+
+// Refs are what a text points to, in order of first appearance and without duplicates
+type Refs struct {
+	// Mentions are the mentioned usernames
+	Mentions []string
+	// Tasks are the IDs, in lower case, or the keys of the referenced tasks
+	Tasks []string
+}
+
+// Parse returns the mentions and references of a text
+func Parse(text string) Refs {
+	var refs Refs
+	seen := map[string]bool{}
+	for _, s := range split(text) {
+		if s.code {
+			continue
+		}
+		for _, m := range mentionPattern.FindAllStringSubmatch(s.text, -1) {
+			if !seen["@"+m[2]] {
+				seen["@"+m[2]] = true
+				refs.Mentions = append(refs.Mentions, m[2])
+			}
+		}
+		for _, m := range referencePattern.FindAllStringSubmatch(s.text, -1) {
+			ref := m[3]
+			if m[2] != "" {
+				ref = strings.ToLower(m[2])
+			}
+			if !seen["#"+ref] {
+				seen["#"+ref] = true
+				refs.Tasks = append(refs.Tasks, ref)
+			}
+		}
+	}
+	return refs
+}
+
+// Added returns the mentions and references of a text that its previous version did not have
+func Added(previous, text string) Refs {
+	before := Parse(previous)
+	known := map[string]bool{}
+	for _, username := range before.Mentions {
+		known["@"+username] = true
+	}
+	for _, taskID := range before.Tasks {
+		known["#"+taskID] = true
+	}
+	var added Refs
+	after := Parse(text)
+	for _, username := range after.Mentions {
+		if !known["@"+username] {
+			added.Mentions = append(added.Mentions, username)
+		}
+	}
+	for _, taskID := range after.Tasks {
+		if !known["#"+taskID] {
+			added.Tasks = append(added.Tasks, taskID)
+		}
+	}
+	return added
+}
+
+// Render converts a Markdown text to sanitized HTML. Mentions become
+// <span class="mention" data-username="..."> and references <span class="task-ref" data-task-id="...">
+// or <span class="task-ref" data-task-key="...">, for clients to link them.
+func Render(text string) string {
+	if strings.TrimSpace(text) == "" {
+		return ""
+	}
+	var b strings.Builder
+	for _, s := range split(text) {
+		if s.code {
+			b.WriteString(s.text)
+			continue
+		}
+		marked := mentionPattern.ReplaceAllString(s.text, `${1}<span class="mention" data-username="${2}">@${2}</span>`)
+		marked = referencePattern.ReplaceAllStringFunc(marked, func(match string) string {
+			m := referencePattern.FindStringSubmatch(match)
+			if m[2] != "" {
+				return fmt.Sprintf(`%s<span class="task-ref" data-task-id="%s">#%[2]s</span>`, m[1], m[2])
+			}
+			return fmt.Sprintf(`%s<span class="task-ref" data-task-key="%s">#%[2]s</span>`, m[1], m[3])
+		})
+		b.WriteString(marked)
+	}
+	return policy.Sanitize(string(blackfriday.Run([]byte(b.String()))))
+}
+
+type segment struct {
+	text string
+	code bool
+}
+
+// split cuts a text into its fenced code blocks and code spans and the prose between them
+func split(text string) []segment {
+	var segments []segment
+	add := func(text string, code bool) {
+		if text == "" {
+			return
+		}
+		if n := len(segments); n > 0 && segments[n-1].code == code {
+			segments[n-1].text += text
+			return
+		}
+		segments = append(segments, segment{text: text, code: code})
+	}
+
+	fence := ""
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if fence != "" {
+			if strings.HasPrefix(strings.TrimSpace(line), fence) {
+				fence = ""
+			}
+			add(line, true)
+			continue
+		}
+		if fence = openingFence(line); fence != "" {
+			add(line, true)
+			continue
+		}
+		splitCodeSpans(line, add)
+	}
+	return segments
+}
+
+// openingFence returns the fence opening a code block on a line, if any
+func openingFence(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return ""
+	}
+	for _, c := range "`~" {
+		if n := runLength(trimmed, 0, byte(c)); n >= 3 {
+			return trimmed[:n]
+		}
+	}
+	return ""
+}
+
+// splitCodeSpans cuts a line into its code spans, delimited by backtick runs of the same length, and the text between them
+func splitCodeSpans(line string, add func(text string, code bool)) {
+	for {
+		start := strings.IndexByte(line, '`')
+		if start < 0 {
+			add(line, false)
+			return
+		}
+		n := runLength(line, start, '`')
+		end := -1
+		for i := start + n; i < len(line); {
+			next := strings.IndexByte(line[i:], '`')
+			if next < 0 {
+				break
+			}
+			m := runLength(line, i+next, '`')
+			if m == n {
+				end = i + next
+				break
+			}
+			i += next + m
+		}
+		if end < 0 {
+			// an unmatched run is literal text
+			add(line[:start+n], false)
+			line = line[start+n:]
+			continue
+		}
+		add(line[:start], false)
+		add(line[start:end+n], true)
+		line = line[end+n:]
+	}
+}
+
+func runLength(s string, from int, c byte) int {
+	n := 0
+	for from+n < len(s) && s[from+n] == c {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"kn-assignment/internal/core/domain"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// emailTemplate renders an email: its text template defines the subject and the plain text body,
+// its HTML one the content of the HTML layout
+type emailTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+func parseEmailTemplate(name string) emailTemplate {
+	return emailTemplate{
+		text: texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/"+name+".txt")),
+		html: htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/layout.html", "templates/"+name+".html")),
+	}
+}
+
+var (
+	// notificationTemplates are the templates of the notification types with their own email
+	notificationTemplates = map[domain.NotificationType]emailTemplate{
+		domain.NotificationAssigned: parseEmailTemplate("assigned"),
+		domain.NotificationOverdue:  parseEmailTemplate("overdue"),
+		domain.NotificationReviewed: parseEmailTemplate("reviewed"),
+	}
+	notificationTemplate = parseEmailTemplate("notification")
+	digestTemplate       = parseEmailTemplate("digest")
+)
+
+func (t emailTemplate) render(to string, data any) (domain.Email, error) {
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return domain.Email{}, err
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return domain.Email{}, err
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return domain.Email{}, err
+	}
+	return domain.Email{
+		To:      to,
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
+
+// NotificationEmail renders the email of a notification, with a generic template for the types without their own
+func NotificationEmail(n domain.NotificationEmail) (domain.Email, error) {
+	t, ok := notificationTemplates[n.Type]
+	if !ok {
+		t = notificationTemplate
+	}
+	data := struct {
+		Username string
+		Title    string
+		Message  string
+		TaskID   string
+	}{Username: n.Username, Message: n.Message}
+	if n.TaskTitle != nil {
+		data.Title = *n.TaskTitle
+	}
+	if n.TaskID != nil {
+		data.TaskID = *n.TaskID
+	}
+	return t.render(n.Email, data)
+}
+
+// DigestEmail renders the daily digest of an employee
+func DigestEmail(to string, digest domain.Digest) (domain.Email, error) {
+	return digestTemplate.render(to, digest)
+}
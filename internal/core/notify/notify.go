@@ -0,0 +1,107 @@
+// Package notify creates the in-app notifications of the changes made by services.
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/port"
+	"kn-assignment/internal/log"
+)
+
+// Send creates the notifications of an event. Each user gets one notification per task and event,
+// the first one naming them, and none about their own changes or the tasks they muted. Failing to
+// notify never fails the reported change, so errors are only logged.
+func Send(ctx context.Context, repo port.NotificationRepository, notifications ...domain.CreateNotificationRequest) {
+	out := make([]domain.CreateNotificationRequest, 0, len(notifications))
+	for _, n := range notifications {
+		if n.UserID == "" && !(n.Watchers && n.TaskID != nil) {
+			continue
+		}
+		out = append(out, n)
+	}
+	if len(out) == 0 {
+		return
+	}
+	if err := repo.CreateNotifications(ctx, out); err != nil {
+		log.Errorf(ctx, "Error creating %d notifications: %s", len(out), err.Error())
+	}
+}
+
+// Assigned tells an employee they were given a task
+func Assigned(taskID, title, assigneeID string, actorID *string) domain.CreateNotificationRequest {
+	return domain.CreateNotificationRequest{
+		UserID:  assigneeID,
+		Type:    domain.NotificationAssigned,
+		TaskID:  &taskID,
+		ActorID: actorID,
+		Message: fmt.Sprintf("You were assigned %q", title),
+	}
+}
+
+// StatusChanged tells the watchers of a task it moved to a status
+func StatusChanged(task domain.Task, status domain.TaskStatus, actorID string) domain.CreateNotificationRequest {
+	return domain.CreateNotificationRequest{
+		Watchers: true,
+		Type:     domain.NotificationStatusChanged,
+		TaskID:   &task.ID,
+		ActorID:  &actorID,
+		Message:  fmt.Sprintf("%q moved to %s", task.Title, status),
+	}
+}
+
+// TaskUpdated tells the watchers of a task about a change other than its status
+func TaskUpdated(taskID, message string, actorID *string) domain.CreateNotificationRequest {
+	return domain.CreateNotificationRequest{
+		Watchers: true,
+		Type:     domain.NotificationTaskUpdated,
+		TaskID:   &taskID,
+		ActorID:  actorID,
+		Message:  message,
+	}
+}
+
+// Reviewed tells the employee who submitted a task for review the outcome of the review
+func Reviewed(task domain.Task, review domain.TaskReview) []domain.CreateNotificationRequest {
+	if review.SubmittedBy == nil {
+		return nil
+	}
+	message := fmt.Sprintf("%q was approved", task.Title)
+	if !review.Approved {
+		message = fmt.Sprintf("%q was sent back: %s", task.Title, review.Feedback)
+	}
+	return []domain.CreateNotificationRequest{{
+		UserID:  *review.SubmittedBy,
+		Type:    domain.NotificationReviewed,
+		TaskID:  &task.ID,
+		ActorID: &review.ReviewerID,
+		Message: message,
+	}}
+}
+
+// Mentioned tells the users mentioned in a text about the task, e.g. "You were mentioned in a comment on %q"
+func Mentioned(taskID, message string, users []domain.User, actorID string) []domain.CreateNotificationRequest {
+	out := make([]domain.CreateNotificationRequest, 0, len(users))
+	for _, user := range users {
+		out = append(out, domain.CreateNotificationRequest{
+			UserID:  user.ID,
+			Type:    domain.NotificationMention,
+			TaskID:  &taskID,
+			ActorID: &actorID,
+			Message: message,
+		})
+	}
+	return out
+}
+
+// Commented tells the watchers of a task it was commented on
+func Commented(task domain.Task, comment domain.TaskComment) domain.CreateNotificationRequest {
+	return domain.CreateNotificationRequest{
+		Watchers: true,
+		Type:     domain.NotificationComment,
+		TaskID:   &task.ID,
+		ActorID:  &comment.AuthorID,
+		Message:  fmt.Sprintf("%s commented on %q", comment.Username, task.Title),
+	}
+}
@@ -1 +1,12 @@
 package port
+
+import (
+	"context"
+
+	"kn-assignment/internal/core/domain"
+)
+
+// Mailer delivers emails to their recipient
+type Mailer interface {
+	Send(ctx context.Context, email domain.Email) error
+}
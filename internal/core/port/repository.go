@@ -3,18 +3,193 @@ package port
 import (
 	"context"
 	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/taskquery"
+	"time"
 )
 
 type TaskRepository interface {
 	CreateTask(ctx context.Context, task domain.CreateTaskRequest, userId string) error
+	CreateTasks(ctx context.Context, tasks []domain.CreateTaskRequest, userId string) ([]domain.Task, error)
 	GetTasksByAssignee(ctx context.Context, assigneeID string) ([]domain.Task, error)
 	UpdateTaskStatus(ctx context.Context, taskID string, status domain.TaskStatus, userId string) error
-	GetAllTasks(ctx context.Context, filter map[string]string, sort, order string) ([]domain.Task, error)
-	GetTaskSummary(ctx context.Context) ([]domain.TaskSummary, error)
+	GetAllTasks(ctx context.Context, filter map[string]string, query *taskquery.Query, sort, order string) ([]domain.Task, error)
+	GetTaskSummary(ctx context.Context, wipLimit int) ([]domain.TaskSummary, error)
 	AssignTask(ctx context.Context, taskID, assigneeID string) error // New method for assigning tasks
 	GetTaskByID(ctx context.Context, taskID string) (domain.Task, error)
-	UpdateTask(ctx context.Context, taskID string, name, description *string) error
+	GetTaskIDByKey(ctx context.Context, key string) (string, error)
+	UpdateTask(ctx context.Context, taskID string, task domain.UpdateTaskRequest) error
 	DeleteTask(ctx context.Context, taskID string) error
+	GetLastRank(ctx context.Context, status domain.TaskStatus) (string, error)
+	RankLast(ctx context.Context, taskID string, status domain.TaskStatus) error
+	MoveTask(ctx context.Context, taskID string, status domain.TaskStatus, rank, userId string) error
+	RebalanceRanks(ctx context.Context, status domain.TaskStatus) error
+	GetWorkloads(ctx context.Context) ([]domain.Workload, error)
+	GetTasksDueBetween(ctx context.Context, start, end time.Time, assigneeID string) ([]domain.Task, error)
+	GetTaskStatusChanges(ctx context.Context, taskIDs []string) ([]domain.TaskStatusChange, error)
+}
+
+type TaskViewRepository interface {
+	CreateTaskView(ctx context.Context, view domain.CreateTaskViewRequest, ownerID string) (domain.TaskView, error)
+	GetTaskViewByID(ctx context.Context, viewID string) (domain.TaskView, error)
+	GetTaskViewsForUser(ctx context.Context, userID string) ([]domain.TaskView, error)
+	GetDefaultTaskView(ctx context.Context) (*domain.TaskView, error)
+	UpdateTaskView(ctx context.Context, viewID string, view domain.UpdateTaskViewRequest) error
+	DeleteTaskView(ctx context.Context, viewID string) error
+}
+
+type TaskTemplateRepository interface {
+	CreateTaskTemplate(ctx context.Context, template domain.CreateTaskTemplateRequest, userID string) (domain.TaskTemplate, error)
+	GetTaskTemplateByID(ctx context.Context, templateID string) (domain.TaskTemplate, error)
+	GetTaskTemplates(ctx context.Context) ([]domain.TaskTemplate, error)
+	UpdateTaskTemplate(ctx context.Context, templateID string, template domain.UpdateTaskTemplateRequest) error
+	DeleteTaskTemplate(ctx context.Context, templateID string) error
+}
+
+type ChecklistRepository interface {
+	GetChecklistItems(ctx context.Context, taskID string) ([]domain.ChecklistItem, error)
+	GetChecklistItemByID(ctx context.Context, itemID string) (domain.ChecklistItem, error)
+	GetLastChecklistPosition(ctx context.Context, taskID string) (string, error)
+	CreateChecklistItem(ctx context.Context, taskID string, item domain.CreateChecklistItemRequest) (domain.ChecklistItem, error)
+	UpdateChecklistItem(ctx context.Context, itemID string, item domain.UpdateChecklistItemRequest) error
+	SetChecklistItemDone(ctx context.Context, taskID, itemID string, done bool, userID string) error
+	MoveChecklistItem(ctx context.Context, itemID, position string) error
+	RebalanceChecklist(ctx context.Context, taskID string) error
+	DeleteChecklistItem(ctx context.Context, taskID, itemID string) error
+	CountOpenRequiredItems(ctx context.Context, taskID string) (int, error)
+}
+
+type ProjectRepository interface {
+	CreateProject(ctx context.Context, project domain.CreateProjectRequest) (domain.Project, error)
+	GetProjectByID(ctx context.Context, projectID string) (domain.Project, error)
+	GetProjectByKey(ctx context.Context, key string) (domain.Project, error)
+	GetProjects(ctx context.Context) ([]domain.Project, error)
+	UpdateProject(ctx context.Context, projectID string, project domain.UpdateProjectRequest) error
+}
+
+type CustomFieldRepository interface {
+	CreateCustomField(ctx context.Context, field domain.CreateCustomFieldRequest) (domain.CustomField, error)
+	GetCustomFieldByID(ctx context.Context, fieldID string) (domain.CustomField, error)
+	GetCustomFieldByKey(ctx context.Context, key string) (domain.CustomField, error)
+	GetCustomFields(ctx context.Context) ([]domain.CustomField, error)
+	UpdateCustomField(ctx context.Context, fieldID string, field domain.UpdateCustomFieldRequest) error
+	DeleteCustomField(ctx context.Context, field domain.CustomField) error
+}
+
+type SLARepository interface {
+	CreateSLAPolicy(ctx context.Context, policy domain.CreateSLAPolicyRequest) (domain.SLAPolicy, error)
+	GetSLAPolicyByID(ctx context.Context, policyID string) (domain.SLAPolicy, error)
+	GetSLAPolicies(ctx context.Context) ([]domain.SLAPolicy, error)
+	UpdateSLAPolicy(ctx context.Context, policyID string, policy domain.UpdateSLAPolicyRequest) error
+	DeleteSLAPolicy(ctx context.Context, policyID string) error
+	StartTaskSLAs(ctx context.Context, taskIDs []string, starts []domain.SLAStart) error
+	GetTaskSLA(ctx context.Context, taskID string) (domain.TaskSLA, error)
+	UpdateTaskSLAStatus(ctx context.Context, taskID string, status domain.TaskStatus) error
+	MarkSLABreaches(ctx context.Context) (int64, error)
+	GetSLAEscalations(ctx context.Context) ([]domain.SLAEscalationCandidate, error)
+	MarkSLAEscalated(ctx context.Context, taskID string, escalatedTo *string) error
+}
+
+type HandoffRepository interface {
+	CreateHandoffRequest(ctx context.Context, taskID string, request domain.CreateHandoffRequest, requestedBy string) (domain.HandoffRequest, error)
+	GetHandoffRequestByID(ctx context.Context, requestID string) (domain.HandoffRequest, error)
+	GetTaskHandoffRequests(ctx context.Context, taskID string) ([]domain.HandoffRequest, error)
+	GetHandoffRequests(ctx context.Context, status domain.HandoffStatus) ([]domain.HandoffRequest, error)
+	ResolveHandoffRequest(ctx context.Context, request domain.HandoffRequest, status domain.HandoffStatus, note string, assigneeID *string, resolvedBy string) error
+}
+
+type ReviewRepository interface {
+	CreateTaskReview(ctx context.Context, task domain.Task, review domain.ReviewTaskRequest, reviewerID string) (domain.TaskReview, error)
+	GetTaskReviews(ctx context.Context, taskID string) ([]domain.TaskReview, error)
+}
+
+type LinkRepository interface {
+	CreateTaskLink(ctx context.Context, sourceTaskID string, link domain.CreateTaskLinkRequest, userID string) (domain.TaskLink, error)
+	GetTaskLinkByID(ctx context.Context, linkID string) (domain.TaskLink, error)
+	GetTaskLinks(ctx context.Context, taskIDs []string) ([]domain.LinkedTask, error)
+	DeleteTaskLink(ctx context.Context, linkID string) error
+	CloseAsDuplicate(ctx context.Context, taskID, originalTaskID, userID string) error
+	CreateReferenceLinks(ctx context.Context, sourceTaskID string, targets []string, userID string) error
+	IsBlockedBy(ctx context.Context, taskID, blockerID string) (bool, error)
+	GetBlockingLinks(ctx context.Context, taskIDs []string) ([]domain.TaskLink, error)
+}
+
+type CommentRepository interface {
+	CreateTaskComment(ctx context.Context, taskID, body, authorID string) (domain.TaskComment, error)
+	GetTaskCommentByID(ctx context.Context, commentID string) (domain.TaskComment, error)
+	GetTaskComments(ctx context.Context, taskID string) ([]domain.TaskComment, error)
+	UpdateTaskComment(ctx context.Context, commentID, body string) (domain.TaskComment, error)
+	DeleteTaskComment(ctx context.Context, commentID string) error
+}
+
+type PoolRepository interface {
+	GetPoolTasks(ctx context.Context) ([]domain.Task, error)
+	SetTaskPooled(ctx context.Context, taskID string, pooled bool, userID string) error
+	ClaimTask(ctx context.Context, taskID, userID string, limit int) error
+	ReleaseTask(ctx context.Context, taskID, userID string) error
+}
+
+type NotificationRepository interface {
+	CreateNotifications(ctx context.Context, notifications []domain.CreateNotificationRequest) error
+	GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]domain.Notification, error)
+	CountUnreadNotifications(ctx context.Context, userID string) (int, error)
+	MarkNotificationRead(ctx context.Context, notificationID, userID string) error
+	MarkAllNotificationsRead(ctx context.Context, userID string) (int64, error)
+	CreateDueSoonNotifications(ctx context.Context, within time.Duration) (int64, error)
+	CreateOverdueNotifications(ctx context.Context, within time.Duration) (int64, error)
+	DeleteNotificationsBefore(ctx context.Context, before time.Time) (int64, error)
+	GetNotificationPreferences(ctx context.Context, userID string) ([]domain.NotificationPreference, error)
+	UpsertNotificationPreferences(ctx context.Context, userID string, preferences []domain.NotificationPreference) error
+	GetPendingEmails(ctx context.Context, since time.Time, limit int) ([]domain.NotificationEmail, error)
+	MarkEmailSent(ctx context.Context, notificationID string) error
+	MarkEmailFailed(ctx context.Context, notificationID string, maxAttempts int) error
+	GetDigestRecipients(ctx context.Context, hour int) ([]domain.User, error)
+	MarkDigestSent(ctx context.Context, userID string, day time.Time) error
+}
+
+type WatcherRepository interface {
+	AutoWatchTasks(ctx context.Context, taskIDs []string) error
+	WatchTask(ctx context.Context, taskID, userID string, muted bool) (domain.TaskWatcher, error)
+	UnwatchTask(ctx context.Context, taskID, userID string) error
+	GetTaskWatchers(ctx context.Context, taskID string) ([]domain.TaskWatcher, error)
+	GetWatchedTasks(ctx context.Context, userID string) ([]domain.Task, error)
+}
+
+type SprintRepository interface {
+	CreateSprint(ctx context.Context, sprint domain.CreateSprintRequest, userID string) (domain.Sprint, error)
+	GetSprintByID(ctx context.Context, sprintID string) (domain.Sprint, error)
+	GetSprints(ctx context.Context, status domain.SprintStatus) ([]domain.Sprint, error)
+	UpdateSprint(ctx context.Context, sprintID string, sprint domain.UpdateSprintRequest) error
+	DeleteSprint(ctx context.Context, sprintID string) error
+	StartSprint(ctx context.Context, sprintID string) error
+	CloseSprint(ctx context.Context, sprintID string, carryOverSprintID *string, userID string) ([]string, error)
+	AddSprintTasks(ctx context.Context, sprintID string, taskIDs []string, userID string) error
+	RemoveSprintTask(ctx context.Context, sprintID, taskID, userID string) error
+	GetSprintScopeChanges(ctx context.Context, sprintID string) ([]domain.SprintScopeChange, error)
+	GetSprintScopeTasks(ctx context.Context, sprintID string) ([]domain.Task, error)
+}
+
+type WIPRepository interface {
+	GetStatusWIPLimits(ctx context.Context) ([]domain.StatusWIPLimit, error)
+	GetStatusWIPLimit(ctx context.Context, status domain.TaskStatus) (*int, error)
+	SetStatusWIPLimit(ctx context.Context, status domain.TaskStatus, limit *int, userID string) error
+	CountStatusTasks(ctx context.Context, status domain.TaskStatus, assigneeID *string, excludeTaskID string) (int, error)
+	CreateWIPOverrides(ctx context.Context, overrides []domain.WIPOverride) error
+	LockWIP(ctx context.Context, statuses []domain.TaskStatus, employeeIDs []string, fn func(ctx context.Context) error) error
+	GetWIPOverrides(ctx context.Context, taskID string) ([]domain.WIPOverride, error)
+}
+
+type AvailabilityRepository interface {
+	GetWorkingHours(ctx context.Context, userID string) (domain.WorkingHours, error)
+	SetWorkingHours(ctx context.Context, userID string, hours domain.WorkingHours) error
+	CreateTimeOff(ctx context.Context, userID string, timeOff domain.CreateTimeOffRequest, createdBy string) (domain.TimeOff, error)
+	GetTimeOffByID(ctx context.Context, timeOffID string) (domain.TimeOff, error)
+	GetTimeOff(ctx context.Context, userID, from, to string) ([]domain.TimeOff, error)
+	GetUsersOff(ctx context.Context, at []time.Time) ([]string, error)
+	DeleteTimeOff(ctx context.Context, timeOffID string) error
+	CreateHoliday(ctx context.Context, holiday domain.CreateHolidayRequest, userID string) (domain.Holiday, error)
+	GetHolidays(ctx context.Context, from, to string) ([]domain.Holiday, error)
+	DeleteHoliday(ctx context.Context, date string) error
+	GetWorkCalendar(ctx context.Context, userID, from string) (domain.WorkCalendar, error)
 }
 
 type AuthRepository interface {
@@ -28,5 +203,12 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user domain.User) error
 	GetUserByUsername(ctx context.Context, username string) (domain.User, error)
 	GetUserByID(ctx context.Context, userID string) (domain.User, error)
+	GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error)
+	GetUsersByUsernames(ctx context.Context, usernames []string) ([]domain.User, error)
 	UpdateUser(ctx context.Context, user domain.User) error
+	UpdateUserSkills(ctx context.Context, userID string, skills []string) error
+	UpdateUserTimezone(ctx context.Context, userID, timezone string) error
+	UpdateUserClaimLimit(ctx context.Context, userID string, limit *int) error
+	UpdateUserWIPLimit(ctx context.Context, userID string, limit *int) error
+	UpdateUserEmail(ctx context.Context, userID string, email *string) error
 }
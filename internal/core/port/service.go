@@ -7,17 +7,170 @@ import (
 
 type TaskService interface {
 	CreateTask(ctx context.Context, task domain.CreateTaskRequest, userId string) error
-	AssignTask(ctx context.Context, taskID, assigneeID string) error
+	CreateTasks(ctx context.Context, tasks []domain.CreateTaskRequest, userID string) ([]domain.Task, error)
+	ValidateAssignee(ctx context.Context, assigneeID string) error
+	QuickAddTask(ctx context.Context, request domain.QuickAddRequest, userID string) (domain.QuickAddResult, error)
+	AssignTask(ctx context.Context, taskID string, request domain.AssignTaskRequest, userID string) error
 	GetTasksByAssignee(ctx context.Context, assigneeID string) ([]domain.Task, error)
 	UpdateTaskStatus(ctx context.Context, taskID string, status domain.TaskStatus, assignee string) error
-	GetAllTasks(ctx context.Context, userRole, userID string, filter map[string]string, sort, order string) ([]domain.Task, error)
+	GetAllTasks(ctx context.Context, userRole, userID string, filter map[string]string, query, sort, order string) ([]domain.Task, error)
+	GetTaskByID(ctx context.Context, taskID string) (domain.Task, error)
+	ResolveTaskID(ctx context.Context, ref string) (string, error)
 	GetTaskSummary(ctx context.Context) ([]domain.TaskSummary, error)
 	VerifyTaskAssignment(ctx context.Context, taskID, userID string) (bool, error)
-	UpdateTask(ctx context.Context, taskID string, name, description *string) error
+	UpdateTask(ctx context.Context, taskID string, task domain.UpdateTaskRequest, userID string) error
 	DeleteTask(ctx context.Context, taskID string) error
+	MoveTask(ctx context.Context, taskID string, move domain.MoveTaskRequest, userRole, userID string) error
+	CheckTaskChange(ctx context.Context, change domain.TaskChange, apply func(ctx context.Context) error) error
+	SuggestAssignees(ctx context.Context, taskID string, limit int) ([]domain.AssigneeSuggestion, error)
+	GetTaskCalendar(ctx context.Context, userRole, userID string, request domain.TaskCalendarRequest) (domain.TaskCalendar, error)
+	GetTaskTimeline(ctx context.Context, userRole, userID string, filter map[string]string, query string) (domain.TaskTimeline, error)
+}
+
+type TaskViewService interface {
+	CreateTaskView(ctx context.Context, view domain.CreateTaskViewRequest, userRole, userID string) (domain.TaskView, error)
+	GetTaskView(ctx context.Context, viewID, userID string) (domain.TaskView, error)
+	GetTaskViews(ctx context.Context, userID string) ([]domain.TaskView, error)
+	GetDefaultTaskView(ctx context.Context) (*domain.TaskView, error)
+	UpdateTaskView(ctx context.Context, viewID string, view domain.UpdateTaskViewRequest, userRole, userID string) error
+	DeleteTaskView(ctx context.Context, viewID, userID string) error
+}
+
+type TaskTemplateService interface {
+	CreateTaskTemplate(ctx context.Context, template domain.CreateTaskTemplateRequest, userID string) (domain.TaskTemplate, error)
+	GetTaskTemplate(ctx context.Context, templateID string) (domain.TaskTemplate, error)
+	GetTaskTemplates(ctx context.Context) ([]domain.TaskTemplate, error)
+	UpdateTaskTemplate(ctx context.Context, templateID string, template domain.UpdateTaskTemplateRequest) error
+	DeleteTaskTemplate(ctx context.Context, templateID string) error
+	CreateTasksFromTemplate(ctx context.Context, templateID string, instances []domain.TemplateInstance, userID string) ([]domain.Task, error)
+}
+
+type ChecklistService interface {
+	GetChecklist(ctx context.Context, taskID, userRole, userID string) ([]domain.ChecklistItem, error)
+	AddChecklistItem(ctx context.Context, taskID string, item domain.CreateChecklistItemRequest) (domain.ChecklistItem, error)
+	UpdateChecklistItem(ctx context.Context, taskID, itemID string, item domain.UpdateChecklistItemRequest) error
+	SetChecklistItemDone(ctx context.Context, taskID, itemID string, done bool, userRole, userID string) error
+	MoveChecklistItem(ctx context.Context, taskID, itemID string, move domain.MoveChecklistItemRequest) error
+	DeleteChecklistItem(ctx context.Context, taskID, itemID string) error
+}
+
+type ProjectService interface {
+	CreateProject(ctx context.Context, project domain.CreateProjectRequest) (domain.Project, error)
+	GetProject(ctx context.Context, projectID string) (domain.Project, error)
+	GetProjects(ctx context.Context) ([]domain.Project, error)
+	UpdateProject(ctx context.Context, projectID string, project domain.UpdateProjectRequest) error
+}
+
+type CustomFieldService interface {
+	CreateCustomField(ctx context.Context, field domain.CreateCustomFieldRequest) (domain.CustomField, error)
+	GetCustomFields(ctx context.Context) ([]domain.CustomField, error)
+	UpdateCustomField(ctx context.Context, fieldID string, field domain.UpdateCustomFieldRequest) error
+	DeleteCustomField(ctx context.Context, fieldID string) error
+}
+
+type SLAService interface {
+	CreateSLAPolicy(ctx context.Context, policy domain.CreateSLAPolicyRequest) (domain.SLAPolicy, error)
+	GetSLAPolicies(ctx context.Context) ([]domain.SLAPolicy, error)
+	UpdateSLAPolicy(ctx context.Context, policyID string, policy domain.UpdateSLAPolicyRequest) error
+	DeleteSLAPolicy(ctx context.Context, policyID string) error
+	GetTaskSLA(ctx context.Context, taskID, userRole, userID string) (domain.TaskSLA, error)
+	// CheckSLAs marks breached targets and escalates tasks about to breach
+	CheckSLAs(ctx context.Context) error
+}
+
+type HandoffService interface {
+	RequestHandoff(ctx context.Context, taskID string, request domain.CreateHandoffRequest, userID string) (domain.HandoffRequest, error)
+	GetTaskHandoffs(ctx context.Context, taskID, userRole, userID string) ([]domain.HandoffRequest, error)
+	GetHandoffRequests(ctx context.Context, status domain.HandoffStatus) ([]domain.HandoffRequest, error)
+	ApproveHandoff(ctx context.Context, requestID string, resolve domain.ResolveHandoffRequest, userID string) error
+	RejectHandoff(ctx context.Context, requestID string, resolve domain.ResolveHandoffRequest, userID string) error
+}
+
+type ReviewService interface {
+	ReviewTask(ctx context.Context, taskID string, review domain.ReviewTaskRequest, userID string) (domain.TaskReview, error)
+	GetTaskReviews(ctx context.Context, taskID, userRole, userID string) ([]domain.TaskReview, error)
+}
+
+type LinkService interface {
+	CreateTaskLink(ctx context.Context, taskID string, link domain.CreateTaskLinkRequest, userRole, userID string) (domain.TaskLink, error)
+	GetTaskLinks(ctx context.Context, taskID, userRole, userID string) ([]domain.LinkedTask, error)
+	GetLinksByTaskIDs(ctx context.Context, taskIDs []string, userRole, userID string) (map[string][]domain.LinkedTask, error)
+	DeleteTaskLink(ctx context.Context, taskID, linkID, userRole, userID string) error
+	CloseAsDuplicate(ctx context.Context, taskID string, request domain.CloseAsDuplicateRequest, userRole, userID string) error
+}
+
+type CommentService interface {
+	CreateTaskComment(ctx context.Context, taskID string, comment domain.TaskCommentRequest, userRole, userID string) (domain.TaskComment, error)
+	GetTaskComments(ctx context.Context, taskID, userRole, userID string) ([]domain.TaskComment, error)
+	UpdateTaskComment(ctx context.Context, taskID, commentID string, comment domain.TaskCommentRequest, userRole, userID string) (domain.TaskComment, error)
+	DeleteTaskComment(ctx context.Context, taskID, commentID, userRole, userID string) error
+}
+
+type PoolService interface {
+	GetPoolTasks(ctx context.Context) ([]domain.Task, error)
+	PublishTask(ctx context.Context, taskID, userID string) error
+	WithdrawTask(ctx context.Context, taskID, userID string) error
+	ClaimTask(ctx context.Context, taskID string, request domain.ClaimTaskRequest, userRole, userID string) error
+	ReleaseTask(ctx context.Context, taskID, userID string) error
+}
+
+type NotificationService interface {
+	GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) (domain.NotificationInbox, error)
+	MarkNotificationRead(ctx context.Context, notificationID, userID string) error
+	MarkAllNotificationsRead(ctx context.Context, userID string) (int64, error)
+	CheckNotifications(ctx context.Context) error
+	GetNotificationPreferences(ctx context.Context, userID string) ([]domain.NotificationPreference, error)
+	UpdateNotificationPreferences(ctx context.Context, userID string, preferences []domain.NotificationPreference) ([]domain.NotificationPreference, error)
+	SendEmails(ctx context.Context) error
+}
+
+type WatcherService interface {
+	WatchTask(ctx context.Context, taskID, userRole, userID string, request domain.WatchTaskRequest) (domain.TaskWatcher, error)
+	UnwatchTask(ctx context.Context, taskID, userID string) error
+	GetTaskWatchers(ctx context.Context, taskID, userRole, userID string) ([]domain.TaskWatcher, error)
+	GetWatchedTasks(ctx context.Context, userRole, userID string) ([]domain.Task, error)
+}
+
+type SprintService interface {
+	CreateSprint(ctx context.Context, sprint domain.CreateSprintRequest, userID string) (domain.Sprint, error)
+	GetSprint(ctx context.Context, sprintID string) (domain.Sprint, error)
+	GetSprints(ctx context.Context, status domain.SprintStatus) ([]domain.Sprint, error)
+	UpdateSprint(ctx context.Context, sprintID string, sprint domain.UpdateSprintRequest) error
+	DeleteSprint(ctx context.Context, sprintID string) error
+	StartSprint(ctx context.Context, sprintID string) (domain.Sprint, error)
+	CloseSprint(ctx context.Context, sprintID string, request domain.CloseSprintRequest, userID string) (domain.CloseSprintResult, error)
+	AddSprintTasks(ctx context.Context, sprintID string, taskIDs []string, userID string) error
+	RemoveSprintTask(ctx context.Context, sprintID, taskID, userID string) error
+	GetSprintBurndown(ctx context.Context, sprintID, timezone, userID string) (domain.SprintBurndown, error)
+}
+
+type WIPService interface {
+	GetWIPLimits(ctx context.Context) (domain.WIPLimits, error)
+	SetStatusWIPLimit(ctx context.Context, request domain.SetStatusWIPLimitRequest, userID string) error
+	GetWIPOverrides(ctx context.Context, taskID string) ([]domain.WIPOverride, error)
+}
+
+type AvailabilityService interface {
+	GetAvailability(ctx context.Context, userID, from, to string) (domain.Availability, error)
+	SetWorkingHours(ctx context.Context, userID string, hours domain.WorkingHours) error
+	CreateTimeOff(ctx context.Context, userID string, timeOff domain.CreateTimeOffRequest, createdBy string) (domain.TimeOff, error)
+	DeleteTimeOff(ctx context.Context, timeOffID, userRole, userID string) error
+	GetHolidays(ctx context.Context, from, to string) ([]domain.Holiday, error)
+	CreateHoliday(ctx context.Context, holiday domain.CreateHolidayRequest, userID string) (domain.Holiday, error)
+	DeleteHoliday(ctx context.Context, date string) error
 }
 
 type AuthService interface {
 	RegisterUser(ctx context.Context, user domain.CreateUserRequest) error
 	AuthenticateUser(ctx context.Context, username, password string) (domain.LoginResponse, error)
 }
+
+type UserService interface {
+	GetUserByID(ctx context.Context, userID string) (domain.User, error)
+	GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error)
+	UpdateUserSkills(ctx context.Context, userID string, skills []string) error
+	UpdateUserTimezone(ctx context.Context, userID, timezone string) error
+	UpdateUserClaimLimit(ctx context.Context, userID string, limit *int) error
+	UpdateUserWIPLimit(ctx context.Context, userID string, limit *int) error
+	UpdateUserEmail(ctx context.Context, userID string, email *string) error
+}
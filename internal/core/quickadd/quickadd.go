@@ -0,0 +1,296 @@
+// Package quickadd reads a task from a line of text, as accepted by POST /tasks/quick:
+//
+//	Prepare Q3 report for @alice by next friday 5pm #finance !high
+//
+// @username names the assignee, #label adds a label and !priority sets the priority, one of
+// !low, !medium, !high and !urgent. The due date follows "by", "due", "on" or "at":
+//
+//	today, tomorrow                  the day
+//	friday, fri                      the coming Friday, today included
+//	next friday, next week           the Friday, or the Monday, of next week
+//	in 3 days, in 2 weeks            days from today
+//	in 3 business days               working days of the user, skipping their days off
+//	in 4 hours                       hours from now
+//	2024-09-30                       the day
+//
+// "today", "tomorrow" and "in ..." may also stand on their own. A time such as 5pm, 5:30pm,
+// 17:00 or noon, optionally after "at", sets the time of the day, which otherwise is the end of
+// the working hours of the user; a time alone, as in "by 5pm", is today, or tomorrow once it passed.
+// Times are read in the timezone of the user. When the text holds several due dates the last one
+// wins, the others staying in the title.
+package quickadd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/workday"
+)
+
+// Task is what a line of text reads as
+type Task struct {
+	Title string
+	// Assignee is the username of the assignee, empty when none is mentioned
+	Assignee string
+	// DueDate is nil when the text holds no due date
+	DueDate  *time.Time
+	Labels   []string
+	Priority domain.TaskPriority
+}
+
+var (
+	mentionPattern  = regexp.MustCompile(`^@([A-Za-z0-9_](?:[A-Za-z0-9_.-]*[A-Za-z0-9_])?)$`)
+	labelPattern    = regexp.MustCompile(`^#([\p{L}\p{N}_][\p{L}\p{N}_.-]*)$`)
+	priorityPattern = regexp.MustCompile(`^!([A-Za-z]+)$`)
+	clockPattern    = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+)
+
+// dueKeywords introduce a due date
+var dueKeywords = map[string]bool{"by": true, "due": true, "on": true, "at": true}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// span is a run of words, from start included to end excluded
+type span struct {
+	start, end int
+	due        time.Time
+}
+
+// Parse reads a task from text, dates being relative to now on the calendar of the user
+func Parse(text string, now time.Time, cal workday.Calendar) (Task, error) {
+	words := strings.Fields(text)
+	now = now.In(cal.Location())
+
+	// the last due date wins, so all of them are read first
+	var due *span
+	for i := 0; i < len(words); i++ {
+		d, n, err := parseDue(words, i, now, cal)
+		if err != nil {
+			return Task{}, err
+		}
+		if n > 0 {
+			due = &span{start: i, end: i + n, due: d}
+			i += n - 1
+		}
+	}
+
+	task := Task{}
+	var title []string
+	for i := 0; i < len(words); i++ {
+		if due != nil && i == due.start {
+			i = due.end - 1
+			continue
+		}
+		word := words[i]
+		switch {
+		case mentionPattern.MatchString(trimPunct(word)):
+			if task.Assignee != "" {
+				return Task{}, fmt.Errorf("only one assignee can be mentioned, found @%s and %s", task.Assignee, trimPunct(word))
+			}
+			task.Assignee = trimPunct(word)[1:]
+			// "for @alice" reads as the assignee, not as part of the title
+			if n := len(title); n > 0 && strings.EqualFold(title[n-1], "for") {
+				title = title[:n-1]
+			}
+		case labelPattern.MatchString(trimPunct(word)):
+			task.Labels = append(task.Labels, trimPunct(word)[1:])
+		case priorityPattern.MatchString(trimPunct(word)):
+			priority := domain.TaskPriority(strings.ToLower(trimPunct(word)[1:]))
+			if !priority.IsValid() {
+				return Task{}, fmt.Errorf("unknown priority %s, use !low, !medium, !high or !urgent", word)
+			}
+			if task.Priority != "" && task.Priority != priority {
+				return Task{}, fmt.Errorf("only one priority can be given, found !%s and %s", task.Priority, word)
+			}
+			task.Priority = priority
+		default:
+			title = append(title, word)
+		}
+	}
+	if due != nil {
+		task.DueDate = &due.due
+	}
+	task.Labels = domain.NormalizeLabels(task.Labels)
+	task.Title = strings.TrimRight(strings.Join(title, " "), " ,;:-")
+	return task, nil
+}
+
+// parseDue reads a due date at words[i], returning how many words it spans, 0 when there is none
+func parseDue(words []string, i int, now time.Time, cal workday.Calendar) (time.Time, int, error) {
+	start := i
+	keyword := false
+	for i < len(words) && dueKeywords[strings.ToLower(words[i])] {
+		i++
+		keyword = true
+	}
+	if i == len(words) {
+		return time.Time{}, 0, nil
+	}
+
+	day, n, exact, err := parseDay(words, i, now, cal, keyword)
+	if err != nil || (n == 0 && !keyword) {
+		return time.Time{}, 0, err
+	}
+	i += n
+	if exact {
+		return day, i - start, nil
+	}
+
+	// a time of day, after "at" when it follows a day
+	j := i
+	if n > 0 && j < len(words) && strings.EqualFold(words[j], "at") {
+		j++
+	}
+	hour, minute, m := parseClock(words, j)
+	if m == 0 {
+		if n == 0 {
+			return time.Time{}, 0, nil
+		}
+		_, end := cal.Hours(day)
+		return end, i - start, nil
+	}
+	if n == 0 {
+		// "by 5pm" is today, or tomorrow once the time passed
+		day = now
+		if clockOn(now, hour, minute).Before(now) {
+			day = now.AddDate(0, 0, 1)
+		}
+	}
+	return clockOn(day, hour, minute), j + m - start, nil
+}
+
+// parseDay reads a day at words[i], returning how many words it spans and whether it is an exact
+// time rather than a day. Weekdays and dates are only read after a keyword.
+func parseDay(words []string, i int, now time.Time, cal workday.Calendar, keyword bool) (time.Time, int, bool, error) {
+	word := strings.ToLower(trimPunct(words[i]))
+	today := clockOn(now, 0, 0)
+	switch word {
+	case "today":
+		return today, 1, false, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), 1, false, nil
+	case "in":
+		return parseOffset(words, i, now, cal)
+	}
+	if !keyword {
+		return time.Time{}, 0, false, nil
+	}
+	if weekday, ok := weekdays[word]; ok {
+		return today.AddDate(0, 0, (int(weekday)-int(today.Weekday())+7)%7), 1, false, nil
+	}
+	if word == "next" && i+1 < len(words) {
+		next := strings.ToLower(trimPunct(words[i+1]))
+		// the Monday of next week
+		monday := today.AddDate(0, 0, 7-(int(today.Weekday())+6)%7)
+		if next == "week" {
+			return monday, 2, false, nil
+		}
+		if weekday, ok := weekdays[next]; ok {
+			return monday.AddDate(0, 0, (int(weekday)+6)%7), 2, false, nil
+		}
+	}
+	if day, err := time.ParseInLocation(time.DateOnly, word, now.Location()); err == nil {
+		return day, 1, false, nil
+	}
+	return time.Time{}, 0, false, nil
+}
+
+// parseOffset reads "in N unit" at words[i]
+func parseOffset(words []string, i int, now time.Time, cal workday.Calendar) (time.Time, int, bool, error) {
+	if i+2 >= len(words) {
+		return time.Time{}, 0, false, nil
+	}
+	count, err := strconv.Atoi(words[i+1])
+	if err != nil || count < 0 {
+		return time.Time{}, 0, false, nil
+	}
+	unit := strings.ToLower(trimPunct(words[i+2]))
+	today := clockOn(now, 0, 0)
+	switch unit {
+	case "hour", "hours":
+		return now.Add(time.Duration(count) * time.Hour), 3, true, nil
+	case "day", "days":
+		return today.AddDate(0, 0, count), 3, false, nil
+	case "week", "weeks":
+		return today.AddDate(0, 0, 7*count), 3, false, nil
+	case "business", "working":
+		if i+3 >= len(words) {
+			return time.Time{}, 0, false, nil
+		}
+		if days := strings.ToLower(trimPunct(words[i+3])); days != "day" && days != "days" {
+			return time.Time{}, 0, false, nil
+		}
+		due, err := cal.AddBusinessDays(now, count)
+		if err != nil {
+			return time.Time{}, 0, false, fmt.Errorf("no working day comes in %d business days", count)
+		}
+		return due, 4, false, nil
+	}
+	return time.Time{}, 0, false, nil
+}
+
+// parseClock reads the hour and minute of a time of day at words[i] such as 5pm, 5:30 pm, 17:00
+// or noon, and how many words it spans, 0 when there is none
+func parseClock(words []string, i int) (int, int, int) {
+	if i >= len(words) {
+		return 0, 0, 0
+	}
+	word := strings.ToLower(trimPunct(words[i]))
+	switch word {
+	case "noon":
+		return 12, 0, 1
+	}
+	n := 1
+	if i+1 < len(words) && clockPattern.MatchString(word) && !strings.HasSuffix(word, "m") {
+		if suffix := strings.ToLower(trimPunct(words[i+1])); suffix == "am" || suffix == "pm" {
+			word += suffix
+			n = 2
+		}
+	}
+	m := clockPattern.FindStringSubmatch(word)
+	// a bare number is not a time, "by 5" could be anything
+	if m == nil || (m[2] == "" && m[3] == "") {
+		return 0, 0, 0
+	}
+	hour, _ := strconv.Atoi(m[1])
+	minute := 0
+	if m[2] != "" {
+		minute, _ = strconv.Atoi(m[2])
+	}
+	if m[3] != "" {
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0
+		}
+		hour %= 12
+		if m[3] == "pm" {
+			hour += 12
+		}
+	}
+	if hour > 23 || minute > 59 {
+		return 0, 0, 0
+	}
+	return hour, minute, n
+}
+
+// clockOn returns the time of day on the day of t, in its location
+func clockOn(t time.Time, hour, minute int) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, hour, minute, 0, 0, t.Location())
+}
+
+// trimPunct trims the punctuation ending a word in a sentence
+func trimPunct(word string) string {
+	return strings.TrimRight(word, ",;:.?")
+}
@@ -0,0 +1,107 @@
+// Package rank implements lexicographic fractional indexing for manually ordered lists.
+//
+// A rank is a string of base-36 digits read as a fraction between 0 and 1, so
+// that comparing ranks as strings orders them. A new rank can always be found
+// between two others, which lets a moved item rewrite only its own rank. Ranks
+// never end with the zero digit, otherwise no rank would fit between "a" and "a0".
+package rank
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+)
+
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// MaxLength is the rank length past which a list should be rebalanced
+const MaxLength = 24
+
+var ErrInvalidRange = errors.New("rank: lower bound must sort before upper bound")
+
+// Between returns a rank sorting strictly after lower and before upper.
+// An empty lower means the start of the list and an empty upper its end.
+func Between(lower, upper string) (string, error) {
+	if !valid(lower) || !valid(upper) {
+		return "", errors.New("rank: invalid rank")
+	}
+	if upper != "" && lower >= upper {
+		return "", ErrInvalidRange
+	}
+	return midpoint(lower, upper), nil
+}
+
+// midpoint assumes lower < upper, with an empty upper meaning 1
+func midpoint(lower, upper string) string {
+	if upper != "" {
+		// keep the common prefix, reading missing digits of lower as zeros
+		n := 0
+		for n < len(upper) && digitAt(lower, n) == upper[n] {
+			n++
+		}
+		if n > 0 {
+			return upper[:n] + midpoint(suffix(lower, n), upper[n:])
+		}
+	}
+
+	lo := 0
+	if lower != "" {
+		lo = strings.IndexByte(digits, lower[0])
+	}
+	hi := len(digits)
+	if upper != "" {
+		hi = strings.IndexByte(digits, upper[0])
+	}
+	if hi-lo > 1 {
+		return string(digits[(lo+hi+1)/2])
+	}
+	// the first digits are consecutive
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+	return string(digits[lo]) + midpoint(suffix(lower, 1), "")
+}
+
+// Spread returns n evenly spaced ranks in ascending order, used to rebalance a list
+func Spread(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	// leave at least 36 free ranks between two neighbors
+	width := int(math.Ceil(math.Log(float64(n+1))/math.Log(float64(len(digits))))) + 1
+	space := new(big.Int).Exp(big.NewInt(int64(len(digits))), big.NewInt(int64(width)), nil)
+
+	ranks := make([]string, n)
+	for i := range ranks {
+		value := new(big.Int).Mul(space, big.NewInt(int64(i+1)))
+		value.Quo(value, big.NewInt(int64(n+1)))
+		key := value.Text(len(digits))
+		key = strings.Repeat("0", width-len(key)) + key
+		ranks[i] = strings.TrimRight(key, "0")
+	}
+	return ranks
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return digits[0]
+}
+
+func suffix(s string, i int) string {
+	if i < len(s) {
+		return s[i:]
+	}
+	return ""
+}
+
+func valid(rank string) bool {
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(digits, rank[i]) < 0 {
+			return false
+		}
+	}
+	return !strings.HasSuffix(rank, digits[:1])
+}
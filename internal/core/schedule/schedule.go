@@ -0,0 +1,166 @@
+// Package schedule runs the critical path method over tasks and their dependencies.
+//
+// Each task is planned from its start to its finish and cannot start before its unfinished
+// blockers finish. The forward pass gives the earliest start and finish of every task, the
+// backward pass from the latest earliest finish of the unfinished tasks its latest start and
+// finish, and their difference is its slack. Unfinished tasks without slack are critical.
+package schedule
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrCycle is returned when dependencies loop, leaving no task to schedule first
+var ErrCycle = errors.New("dependencies form a cycle")
+
+// Item is a task to schedule. Done tasks no longer hold back the tasks they block.
+type Item struct {
+	ID     string
+	Start  time.Time
+	Finish time.Time
+	Done   bool
+	// Blockers are the IDs of the items that must finish before this one starts. Unknown IDs are ignored.
+	Blockers []string
+}
+
+type Result struct {
+	EarliestStart  time.Time
+	EarliestFinish time.Time
+	LatestStart    time.Time
+	LatestFinish   time.Time
+	Slack          time.Duration
+	Critical       bool
+}
+
+// Plan schedules items and returns their results by ID and a critical path, the chain of
+// critical items ending last, from its first item to its last one
+func Plan(items []Item) (map[string]Result, []string, error) {
+	byID := make(map[string]Item, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	order, err := sortItems(items, byID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make(map[string]Result, len(items))
+	var end time.Time
+	for _, item := range order {
+		start := item.Start
+		for _, id := range item.Blockers {
+			blocker, ok := byID[id]
+			if !ok || blocker.Done {
+				continue
+			}
+			if finish := results[id].EarliestFinish; finish.After(start) {
+				start = finish
+			}
+		}
+		finish := start.Add(item.Finish.Sub(item.Start))
+		results[item.ID] = Result{EarliestStart: start, EarliestFinish: finish}
+		if !item.Done && finish.After(end) {
+			end = finish
+		}
+	}
+
+	dependents := make(map[string][]string)
+	for _, item := range items {
+		for _, id := range item.Blockers {
+			if blocker, ok := byID[id]; ok && !blocker.Done {
+				dependents[id] = append(dependents[id], item.ID)
+			}
+		}
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		item := order[i]
+		r := results[item.ID]
+		if item.Done {
+			// done tasks are where they were, without slack nor influence on the others
+			r.LatestStart, r.LatestFinish = r.EarliestStart, r.EarliestFinish
+			results[item.ID] = r
+			continue
+		}
+		r.LatestFinish = end
+		for _, id := range dependents[item.ID] {
+			if start := results[id].LatestStart; start.Before(r.LatestFinish) {
+				r.LatestFinish = start
+			}
+		}
+		r.LatestStart = r.LatestFinish.Add(-item.Finish.Sub(item.Start))
+		r.Slack = r.LatestStart.Sub(r.EarliestStart)
+		r.Critical = r.Slack <= 0
+		results[item.ID] = r
+	}
+	return results, criticalPath(order, byID, results, end), nil
+}
+
+// sortItems orders items so that every item comes after its blockers, keeping the given order otherwise
+func sortItems(items []Item, byID map[string]Item) ([]Item, error) {
+	pending := make(map[string]int, len(items))
+	dependents := make(map[string][]string)
+	for _, item := range items {
+		for _, id := range item.Blockers {
+			if _, ok := byID[id]; ok {
+				pending[item.ID]++
+				dependents[id] = append(dependents[id], item.ID)
+			}
+		}
+	}
+	var ready []string
+	for _, item := range items {
+		if pending[item.ID] == 0 {
+			ready = append(ready, item.ID)
+		}
+	}
+	order := make([]Item, 0, len(items))
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		order = append(order, byID[id])
+		for _, dependent := range dependents[id] {
+			if pending[dependent]--; pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if len(order) < len(byID) {
+		return nil, ErrCycle
+	}
+	return order, nil
+}
+
+// criticalPath walks back from the critical item finishing last through the critical blockers holding it back
+func criticalPath(order []Item, byID map[string]Item, results map[string]Result, end time.Time) []string {
+	var last *Item
+	for i := len(order) - 1; i >= 0; i-- {
+		if r := results[order[i].ID]; r.Critical && r.EarliestFinish.Equal(end) {
+			last = &order[i]
+			break
+		}
+	}
+	if last == nil {
+		return []string{}
+	}
+	path := []string{last.ID}
+	for current := *last; ; {
+		start := results[current.ID].EarliestStart
+		found := false
+		for _, id := range current.Blockers {
+			blocker, ok := byID[id]
+			if r := results[id]; ok && r.Critical && r.EarliestFinish.Equal(start) {
+				current, found = blocker, true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+		path = append(path, current.ID)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
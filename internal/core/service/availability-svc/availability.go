@@ -0,0 +1,205 @@
+package availabilitysvc
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/workday"
+	"kn-assignment/internal/log"
+)
+
+const (
+	// maxRangeDays bounds availability ranges and time off to about a year
+	maxRangeDays = 366
+	// defaultRangeDays is the range of availability without an end, four weeks
+	defaultRangeDays = 28
+)
+
+// GetAvailability lists the days between two local days of a user, both included, with whether
+// they work on them. The range defaults to four weeks from today in the timezone of the user.
+func (s *service) GetAvailability(ctx context.Context, userID, from, to string) (domain.Availability, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return domain.Availability{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "User not found")
+	}
+	loc, err := domain.LoadTimezone(user.Timezone)
+	if err != nil {
+		return domain.Availability{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	start := time.Now().In(loc)
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	if from != "" {
+		if start, err = time.ParseInLocation(time.DateOnly, from, loc); err != nil {
+			return domain.Availability{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "From must be a YYYY-MM-DD date")
+		}
+	}
+	end := start.AddDate(0, 0, defaultRangeDays-1)
+	if to != "" {
+		if end, err = time.ParseInLocation(time.DateOnly, to, loc); err != nil {
+			return domain.Availability{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "To must be a YYYY-MM-DD date")
+		}
+	}
+	if err := validateRange(start, end, "To must not be before from", "An availability spans at most 366 days"); err != nil {
+		return domain.Availability{}, err
+	}
+
+	cal, err := s.availabilityRepo.GetWorkCalendar(ctx, userID, start.Format(time.DateOnly))
+	if err != nil {
+		return domain.Availability{}, err
+	}
+	calendar, err := workday.New(cal)
+	if err != nil {
+		log.Errorf(ctx, "Invalid work calendar of user %s: %s", userID, err.Error())
+		return domain.Availability{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	timeOff, err := s.availabilityRepo.GetTimeOff(ctx, userID, start.Format(time.DateOnly), end.Format(time.DateOnly))
+	if err != nil {
+		return domain.Availability{}, err
+	}
+	if timeOff == nil {
+		timeOff = []domain.TimeOff{}
+	}
+
+	availability := domain.Availability{
+		UserID:       userID,
+		Timezone:     loc.String(),
+		WorkingHours: cal.WorkingHours,
+		From:         start.Format(time.DateOnly),
+		To:           end.Format(time.DateOnly),
+		TimeOff:      timeOff,
+	}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		working, reason := calendar.Day(day)
+		ad := domain.AvailabilityDay{Date: day.Format(time.DateOnly), Working: working, Reason: reason}
+		if working {
+			dayStart, dayEnd := calendar.Hours(day)
+			ad.Start, ad.End = &dayStart, &dayEnd
+		}
+		availability.Days = append(availability.Days, ad)
+	}
+	return availability, nil
+}
+
+// SetWorkingHours sets the weekdays and hours a user works on
+func (s *service) SetWorkingHours(ctx context.Context, userID string, hours domain.WorkingHours) error {
+	if len(hours.Days) == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Days are required")
+	}
+	for _, day := range hours.Days {
+		if day < 1 || day > 7 {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Days must be ISO weekdays, from 1 for Monday to 7 for Sunday")
+		}
+	}
+	slices.Sort(hours.Days)
+	hours.Days = slices.Compact(hours.Days)
+
+	start, err := workday.ParseClock(hours.Start)
+	if err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Start must be an HH:MM time")
+	}
+	end, err := workday.ParseClock(hours.End)
+	if err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "End must be an HH:MM time")
+	}
+	if end <= start {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "End must be after start")
+	}
+	return s.availabilityRepo.SetWorkingHours(ctx, userID, hours)
+}
+
+// CreateTimeOff records a leave of a user, which cannot overlap their other time off
+func (s *service) CreateTimeOff(ctx context.Context, userID string, timeOff domain.CreateTimeOffRequest, createdBy string) (domain.TimeOff, error) {
+	timeOff.Reason = strings.TrimSpace(timeOff.Reason)
+	start, err := time.Parse(time.DateOnly, timeOff.StartDate)
+	if err != nil {
+		return domain.TimeOff{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Start date must be a YYYY-MM-DD date")
+	}
+	end, err := time.Parse(time.DateOnly, timeOff.EndDate)
+	if err != nil {
+		return domain.TimeOff{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "End date must be a YYYY-MM-DD date")
+	}
+	if err := validateRange(start, end, "End date must not be before start date", "Time off spans at most 366 days"); err != nil {
+		return domain.TimeOff{}, err
+	}
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		return domain.TimeOff{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "User not found")
+	}
+
+	overlapping, err := s.availabilityRepo.GetTimeOff(ctx, userID, timeOff.StartDate, timeOff.EndDate)
+	if err != nil {
+		return domain.TimeOff{}, err
+	}
+	if len(overlapping) > 0 {
+		return domain.TimeOff{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict,
+			"The time off overlaps the time off from "+overlapping[0].StartDate+" to "+overlapping[0].EndDate)
+	}
+	return s.availabilityRepo.CreateTimeOff(ctx, userID, timeOff, createdBy)
+}
+
+// DeleteTimeOff deletes time off of the user, or of anyone for employers
+func (s *service) DeleteTimeOff(ctx context.Context, timeOffID, userRole, userID string) error {
+	timeOff, err := s.availabilityRepo.GetTimeOffByID(ctx, timeOffID)
+	if err != nil {
+		return err
+	}
+	if userRole != string(domain.RoleEmployer) && timeOff.UserID != userID {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only delete your own time off")
+	}
+	return s.availabilityRepo.DeleteTimeOff(ctx, timeOffID)
+}
+
+// GetHolidays returns the holidays between two days, both included, the current year by default
+func (s *service) GetHolidays(ctx context.Context, from, to string) ([]domain.Holiday, error) {
+	year := time.Now().Year()
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	var err error
+	if from != "" {
+		if start, err = time.Parse(time.DateOnly, from); err != nil {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "From must be a YYYY-MM-DD date")
+		}
+	}
+	if to != "" {
+		if end, err = time.Parse(time.DateOnly, to); err != nil {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "To must be a YYYY-MM-DD date")
+		}
+	}
+	if end.Before(start) {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "To must not be before from")
+	}
+	return s.availabilityRepo.GetHolidays(ctx, start.Format(time.DateOnly), end.Format(time.DateOnly))
+}
+
+func (s *service) CreateHoliday(ctx context.Context, holiday domain.CreateHolidayRequest, userID string) (domain.Holiday, error) {
+	holiday.Name = strings.TrimSpace(holiday.Name)
+	if holiday.Name == "" {
+		return domain.Holiday{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+	}
+	if _, err := time.Parse(time.DateOnly, holiday.Date); err != nil {
+		return domain.Holiday{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Date must be a YYYY-MM-DD date")
+	}
+	return s.availabilityRepo.CreateHoliday(ctx, holiday, userID)
+}
+
+func (s *service) DeleteHoliday(ctx context.Context, date string) error {
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Date must be a YYYY-MM-DD date")
+	}
+	return s.availabilityRepo.DeleteHoliday(ctx, date)
+}
+
+func validateRange(start, end time.Time, beforeMessage, tooLongMessage string) error {
+	if end.Before(start) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, beforeMessage)
+	}
+	if end.After(start.AddDate(0, 0, maxRangeDays-1)) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, tooLongMessage)
+	}
+	return nil
+}
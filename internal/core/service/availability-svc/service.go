@@ -0,0 +1,12 @@
+package availabilitysvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	availabilityRepo port.AvailabilityRepository
+	userRepo         port.UserRepository
+}
+
+func New(availabilityRepo port.AvailabilityRepository, userRepo port.UserRepository) port.AvailabilityService {
+	return &service{availabilityRepo: availabilityRepo, userRepo: userRepo}
+}
@@ -0,0 +1,162 @@
+package checklistsvc
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/rank"
+	"kn-assignment/internal/log"
+)
+
+func (s *service) GetChecklist(ctx context.Context, taskID, userRole, userID string) ([]domain.ChecklistItem, error) {
+	if err := s.checkAccess(ctx, taskID, userRole, userID); err != nil {
+		return nil, err
+	}
+	return s.checklistRepo.GetChecklistItems(ctx, taskID)
+}
+
+func (s *service) AddChecklistItem(ctx context.Context, taskID string, item domain.CreateChecklistItemRequest) (domain.ChecklistItem, error) {
+	item.Content = strings.TrimSpace(item.Content)
+	if item.Content == "" {
+		return domain.ChecklistItem{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Content is required")
+	}
+	if _, err := s.taskRepo.GetTaskByID(ctx, taskID); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	// new items go to the bottom of the checklist
+	lastPosition, err := s.checklistRepo.GetLastChecklistPosition(ctx, taskID)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+	if item.Position, err = rank.Between(lastPosition, ""); err != nil {
+		log.Errorf(ctx, "Error positioning checklist item: %s", err.Error())
+		return domain.ChecklistItem{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return s.checklistRepo.CreateChecklistItem(ctx, taskID, item)
+}
+
+func (s *service) UpdateChecklistItem(ctx context.Context, taskID, itemID string, item domain.UpdateChecklistItemRequest) error {
+	if item.Content == nil && item.Required == nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Nothing to update")
+	}
+	if item.Content != nil {
+		content := strings.TrimSpace(*item.Content)
+		if content == "" {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Content is required")
+		}
+		item.Content = &content
+	}
+	if _, err := s.getItem(ctx, taskID, itemID); err != nil {
+		return err
+	}
+	return s.checklistRepo.UpdateChecklistItem(ctx, itemID, item)
+}
+
+// SetChecklistItemDone ticks or unticks an item. Employees can only tick items of tasks assigned to them.
+func (s *service) SetChecklistItemDone(ctx context.Context, taskID, itemID string, done bool, userRole, userID string) error {
+	if err := s.checkAccess(ctx, taskID, userRole, userID); err != nil {
+		return err
+	}
+	if _, err := s.getItem(ctx, taskID, itemID); err != nil {
+		return err
+	}
+	return s.checklistRepo.SetChecklistItemDone(ctx, taskID, itemID, done, userID)
+}
+
+func (s *service) MoveChecklistItem(ctx context.Context, taskID, itemID string, move domain.MoveChecklistItemRequest) error {
+	if itemID == move.PreviousItemID || itemID == move.NextItemID {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "An item cannot be its own neighbor")
+	}
+	if _, err := s.getItem(ctx, taskID, itemID); err != nil {
+		return err
+	}
+
+	position, err := s.positionBetween(ctx, taskID, move)
+	if stderrors.Is(err, rank.ErrInvalidRange) {
+		// neighbors share a position: spread the checklist and retry once
+		if err := s.checklistRepo.RebalanceChecklist(ctx, taskID); err != nil {
+			return err
+		}
+		position, err = s.positionBetween(ctx, taskID, move)
+	}
+	if stderrors.Is(err, rank.ErrInvalidRange) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Previous item must come before next item")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.checklistRepo.MoveChecklistItem(ctx, itemID, position); err != nil {
+		return err
+	}
+	if len(position) > rank.MaxLength {
+		return s.checklistRepo.RebalanceChecklist(ctx, taskID)
+	}
+	return nil
+}
+
+func (s *service) DeleteChecklistItem(ctx context.Context, taskID, itemID string) error {
+	if _, err := s.getItem(ctx, taskID, itemID); err != nil {
+		return err
+	}
+	return s.checklistRepo.DeleteChecklistItem(ctx, taskID, itemID)
+}
+
+// positionBetween returns a position between the neighbors of a move
+func (s *service) positionBetween(ctx context.Context, taskID string, move domain.MoveChecklistItemRequest) (string, error) {
+	previous, err := s.neighborPosition(ctx, taskID, move.PreviousItemID)
+	if err != nil {
+		return "", err
+	}
+	next, err := s.neighborPosition(ctx, taskID, move.NextItemID)
+	if err != nil {
+		return "", err
+	}
+	if move.PreviousItemID == "" && move.NextItemID == "" {
+		// no neighbors given: move to the bottom
+		if previous, err = s.checklistRepo.GetLastChecklistPosition(ctx, taskID); err != nil {
+			return "", err
+		}
+	}
+	return rank.Between(previous, next)
+}
+
+func (s *service) neighborPosition(ctx context.Context, taskID, itemID string) (string, error) {
+	if itemID == "" {
+		return "", nil
+	}
+	neighbor, err := s.getItem(ctx, taskID, itemID)
+	if err != nil {
+		return "", err
+	}
+	return neighbor.Position, nil
+}
+
+// getItem returns an item of the task, hiding items of other tasks
+func (s *service) getItem(ctx context.Context, taskID, itemID string) (domain.ChecklistItem, error) {
+	item, err := s.checklistRepo.GetChecklistItemByID(ctx, itemID)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+	if item.TaskID != taskID {
+		return domain.ChecklistItem{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Checklist item not found")
+	}
+	return item, nil
+}
+
+// checkAccess lets employers reach any task and employees only the tasks assigned to them
+func (s *service) checkAccess(ctx context.Context, taskID, userRole, userID string) error {
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if userRole != string(domain.RoleEmployer) && (task.AssigneeID == nil || *task.AssigneeID != userID) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only access checklists of tasks assigned to you")
+	}
+	return nil
+}
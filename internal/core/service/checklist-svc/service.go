@@ -0,0 +1,12 @@
+package checklistsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	checklistRepo port.ChecklistRepository
+	taskRepo      port.TaskRepository
+}
+
+func New(checklistRepo port.ChecklistRepository, taskRepo port.TaskRepository) port.ChecklistService {
+	return &service{checklistRepo: checklistRepo, taskRepo: taskRepo}
+}
@@ -0,0 +1,130 @@
+package commentsvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/markup"
+	"kn-assignment/internal/core/notify"
+	"kn-assignment/internal/log"
+)
+
+// CreateTaskComment comments on a task, notifying the mentioned users and the watchers of the task
+// and linking the task to the tasks the comment references
+func (s *service) CreateTaskComment(ctx context.Context, taskID string, comment domain.TaskCommentRequest, userRole, userID string) (domain.TaskComment, error) {
+	if strings.TrimSpace(comment.Body) == "" {
+		return domain.TaskComment{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Body is required")
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return domain.TaskComment{}, err
+	}
+
+	created, err := s.commentRepo.CreateTaskComment(ctx, taskID, comment.Body, userID)
+	if err != nil {
+		return domain.TaskComment{}, err
+	}
+	mentioned, err := s.followRefs(ctx, taskID, markup.Parse(created.Body), userID)
+	if err != nil {
+		return domain.TaskComment{}, err
+	}
+	// mentioned watchers get the mention rather than the comment notification
+	notify.Send(ctx, s.notifyRepo, append(notify.Mentioned(taskID, fmt.Sprintf("You were mentioned in a comment on %q", task.Title), mentioned, userID),
+		notify.Commented(task, created))...)
+	created.BodyHTML = markup.Render(created.Body)
+	return created, nil
+}
+
+// GetTaskComments returns the comments of a task, oldest first
+func (s *service) GetTaskComments(ctx context.Context, taskID, userRole, userID string) ([]domain.TaskComment, error) {
+	if _, err := s.taskRepo.GetTaskByID(ctx, taskID); err != nil {
+		return nil, err
+	}
+	comments, err := s.commentRepo.GetTaskComments(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range comments {
+		comments[i].BodyHTML = markup.Render(comments[i].Body)
+	}
+	return comments, nil
+}
+
+// UpdateTaskComment edits a comment of the caller. Only the users and tasks the edit newly
+// mentions or references are notified and linked.
+func (s *service) UpdateTaskComment(ctx context.Context, taskID, commentID string, comment domain.TaskCommentRequest, userRole, userID string) (domain.TaskComment, error) {
+	if strings.TrimSpace(comment.Body) == "" {
+		return domain.TaskComment{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Body is required")
+	}
+	existing, err := s.getComment(ctx, taskID, commentID)
+	if err != nil {
+		return domain.TaskComment{}, err
+	}
+	if existing.AuthorID != userID {
+		return domain.TaskComment{}, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only edit your own comments")
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return domain.TaskComment{}, err
+	}
+
+	updated, err := s.commentRepo.UpdateTaskComment(ctx, commentID, comment.Body)
+	if err != nil {
+		return domain.TaskComment{}, err
+	}
+	mentioned, err := s.followRefs(ctx, taskID, markup.Added(existing.Body, updated.Body), userID)
+	if err != nil {
+		return domain.TaskComment{}, err
+	}
+	notify.Send(ctx, s.notifyRepo, notify.Mentioned(taskID, fmt.Sprintf("You were mentioned in a comment on %q", task.Title), mentioned, userID)...)
+	updated.BodyHTML = markup.Render(updated.Body)
+	return updated, nil
+}
+
+// DeleteTaskComment deletes a comment of the caller, or any comment for employers.
+// The links its references created are kept.
+func (s *service) DeleteTaskComment(ctx context.Context, taskID, commentID, userRole, userID string) error {
+	existing, err := s.getComment(ctx, taskID, commentID)
+	if err != nil {
+		return err
+	}
+	if userRole != string(domain.RoleEmployer) && existing.AuthorID != userID {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only delete your own comments")
+	}
+	log.Infof(ctx, "Deleting comment %s of task %s", commentID, taskID)
+	return s.commentRepo.DeleteTaskComment(ctx, commentID)
+}
+
+// getComment returns a comment of a task
+func (s *service) getComment(ctx context.Context, taskID, commentID string) (domain.TaskComment, error) {
+	comment, err := s.commentRepo.GetTaskCommentByID(ctx, commentID)
+	if err != nil {
+		return domain.TaskComment{}, err
+	}
+	if comment.TaskID != taskID {
+		return domain.TaskComment{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Comment not found")
+	}
+	return comment, nil
+}
+
+// followRefs links a task to the tasks it references and returns the mentioned users who exist
+func (s *service) followRefs(ctx context.Context, taskID string, refs markup.Refs, userID string) ([]domain.User, error) {
+	if len(refs.Tasks) > 0 {
+		if err := s.linkRepo.CreateReferenceLinks(ctx, taskID, refs.Tasks, userID); err != nil {
+			return nil, err
+		}
+	}
+	if len(refs.Mentions) == 0 {
+		return nil, nil
+	}
+	users, err := s.userRepo.GetUsersByUsernames(ctx, refs.Mentions)
+	if err != nil {
+		log.Errorf(ctx, "Error getting mentioned users: %s", err.Error())
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return users, nil
+}
@@ -0,0 +1,16 @@
+package commentsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	commentRepo port.CommentRepository
+	taskRepo    port.TaskRepository
+	userRepo    port.UserRepository
+	linkRepo    port.LinkRepository
+	notifyRepo  port.NotificationRepository
+}
+
+func New(commentRepo port.CommentRepository, taskRepo port.TaskRepository, userRepo port.UserRepository, linkRepo port.LinkRepository,
+	notifyRepo port.NotificationRepository) port.CommentService {
+	return &service{commentRepo: commentRepo, taskRepo: taskRepo, userRepo: userRepo, linkRepo: linkRepo, notifyRepo: notifyRepo}
+}
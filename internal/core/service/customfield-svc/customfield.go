@@ -0,0 +1,115 @@
+package customfieldsvc
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+)
+
+// keyPattern keeps keys usable as cf.<key> in task queries
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+func (s *service) CreateCustomField(ctx context.Context, field domain.CreateCustomFieldRequest) (domain.CustomField, error) {
+	field.Key = strings.TrimSpace(field.Key)
+	field.Name = strings.TrimSpace(field.Name)
+	if !keyPattern.MatchString(field.Key) {
+		return domain.CustomField{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Key must be lowercase letters, digits and underscores, starting with a letter")
+	}
+	if !field.Type.IsValid() {
+		return domain.CustomField{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Type must be text, number, date, enum, multi_enum or user")
+	}
+	options, err := validateOptions(field.Type, field.Options)
+	if err != nil {
+		return domain.CustomField{}, err
+	}
+	field.Options = options
+	if err := validateName(field.Name); err != nil {
+		return domain.CustomField{}, err
+	}
+	if field.ProjectID != nil {
+		if _, err := s.projectRepo.GetProjectByID(ctx, *field.ProjectID); err != nil {
+			return domain.CustomField{}, err
+		}
+	}
+	if _, err := s.fieldRepo.GetCustomFieldByKey(ctx, field.Key); err == nil {
+		log.Infof(ctx, "Custom field %q already exists", field.Key)
+		return domain.CustomField{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "A custom field with this key already exists")
+	}
+	return s.fieldRepo.CreateCustomField(ctx, field)
+}
+
+func (s *service) GetCustomFields(ctx context.Context) ([]domain.CustomField, error) {
+	return s.fieldRepo.GetCustomFields(ctx)
+}
+
+func (s *service) UpdateCustomField(ctx context.Context, fieldID string, field domain.UpdateCustomFieldRequest) error {
+	if field.Name == nil && field.Options == nil && field.Required == nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Nothing to update")
+	}
+	existing, err := s.fieldRepo.GetCustomFieldByID(ctx, fieldID)
+	if err != nil {
+		return err
+	}
+	if field.Name != nil {
+		name := strings.TrimSpace(*field.Name)
+		if err := validateName(name); err != nil {
+			return err
+		}
+		field.Name = &name
+	}
+	if field.Options != nil {
+		options, err := validateOptions(existing.Type, *field.Options)
+		if err != nil {
+			return err
+		}
+		field.Options = &options
+	}
+	return s.fieldRepo.UpdateCustomField(ctx, fieldID, field)
+}
+
+// DeleteCustomField deletes a definition along with its values on tasks
+func (s *service) DeleteCustomField(ctx context.Context, fieldID string) error {
+	field, err := s.fieldRepo.GetCustomFieldByID(ctx, fieldID)
+	if err != nil {
+		return err
+	}
+	return s.fieldRepo.DeleteCustomField(ctx, field)
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+	}
+	return nil
+}
+
+// validateOptions requires distinct options for enum fields and none for other types
+func validateOptions(fieldType domain.CustomFieldType, options []string) ([]string, error) {
+	isEnum := fieldType == domain.CustomFieldEnum || fieldType == domain.CustomFieldMultiEnum
+	if !isEnum {
+		if len(options) > 0 {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Only enum fields have options")
+		}
+		return []string{}, nil
+	}
+
+	out := make([]string, 0, len(options))
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || seen[option] {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Options must be distinct and not empty")
+		}
+		seen[option] = true
+		out = append(out, option)
+	}
+	if len(out) == 0 {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Enum fields need at least one option")
+	}
+	return out, nil
+}
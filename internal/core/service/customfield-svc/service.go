@@ -0,0 +1,12 @@
+package customfieldsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	fieldRepo   port.CustomFieldRepository
+	projectRepo port.ProjectRepository
+}
+
+func New(fieldRepo port.CustomFieldRepository, projectRepo port.ProjectRepository) port.CustomFieldService {
+	return &service{fieldRepo: fieldRepo, projectRepo: projectRepo}
+}
@@ -0,0 +1,181 @@
+package handoffsvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/notify"
+	"kn-assignment/internal/log"
+)
+
+// RequestHandoff lets the assignee of an open task ask to reassign or decline it
+func (s *service) RequestHandoff(ctx context.Context, taskID string, request domain.CreateHandoffRequest, userID string) (domain.HandoffRequest, error) {
+	request.Reason = strings.TrimSpace(request.Reason)
+	if !request.Type.IsValid() {
+		return domain.HandoffRequest{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Type must be reassign or decline")
+	}
+	if request.Reason == "" {
+		return domain.HandoffRequest{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Reason is required")
+	}
+	if request.SuggestedAssigneeID != nil {
+		if request.Type != domain.HandoffReassign {
+			return domain.HandoffRequest{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Only reassignments have a suggested assignee")
+		}
+		if err := s.checkAssignee(ctx, *request.SuggestedAssigneeID, userID); err != nil {
+			return domain.HandoffRequest{}, err
+		}
+	}
+
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return domain.HandoffRequest{}, err
+	}
+	if task.AssigneeID == nil || *task.AssigneeID != userID {
+		return domain.HandoffRequest{}, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only hand off tasks assigned to you")
+	}
+	if task.Status == domain.StatusCompleted {
+		return domain.HandoffRequest{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Completed tasks cannot be handed off")
+	}
+	if pending, err := s.hasPendingRequest(ctx, taskID); err != nil {
+		return domain.HandoffRequest{}, err
+	} else if pending {
+		return domain.HandoffRequest{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "The task already has a pending handoff request")
+	}
+
+	log.Infof(ctx, "Handoff of task %s requested by %s: %s", taskID, userID, request.Type)
+	return s.handoffRepo.CreateHandoffRequest(ctx, taskID, request, userID)
+}
+
+// GetTaskHandoffs returns the handoff history of a task to employers and its assignee
+func (s *service) GetTaskHandoffs(ctx context.Context, taskID, userRole, userID string) ([]domain.HandoffRequest, error) {
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if userRole != string(domain.RoleEmployer) && (task.AssigneeID == nil || *task.AssigneeID != userID) {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only access the handoffs of tasks assigned to you")
+	}
+	return s.handoffRepo.GetTaskHandoffRequests(ctx, taskID)
+}
+
+func (s *service) GetHandoffRequests(ctx context.Context, status domain.HandoffStatus) ([]domain.HandoffRequest, error) {
+	if status == "" {
+		status = domain.HandoffPending
+	}
+	if !status.IsValid() {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Status must be pending, approved or rejected")
+	}
+	return s.handoffRepo.GetHandoffRequests(ctx, status)
+}
+
+// ApproveHandoff hands the task over: a reassigned task goes to the given or suggested
+// employee, a declined task is left unassigned
+func (s *service) ApproveHandoff(ctx context.Context, requestID string, resolve domain.ResolveHandoffRequest, userID string) error {
+	request, task, err := s.resolvableRequest(ctx, requestID)
+	if err != nil {
+		return err
+	}
+
+	var assigneeID *string
+	if request.Type == domain.HandoffReassign {
+		assigneeID = resolve.AssigneeID
+		if assigneeID == nil {
+			assigneeID = request.SuggestedAssigneeID
+		}
+		if assigneeID == nil {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Assignee ID is required to approve a reassignment")
+		}
+		if err := s.checkAssignee(ctx, *assigneeID, request.RequestedBy); err != nil {
+			return err
+		}
+	} else if resolve.AssigneeID != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Declined tasks are left unassigned")
+	}
+
+	// the new assignee gets the task within their WIP limits and outside their time off as with any
+	// assignment, which the employer can override
+	change := domain.TaskChange{Task: task, Status: task.Status, AssigneeID: assigneeID, OverrideNote: resolve.OverrideNote,
+		AllowTimeOff: resolve.AllowTimeOff, UserRole: string(domain.RoleEmployer), UserID: userID}
+	err = s.taskService.CheckTaskChange(ctx, change, func(ctx context.Context) error {
+		return s.handoffRepo.ResolveHandoffRequest(ctx, request, domain.HandoffApproved, strings.TrimSpace(resolve.Note), assigneeID, userID)
+	})
+	if err != nil {
+		return err
+	}
+	if assigneeID == nil {
+		notify.Send(ctx, s.notifyRepo, notify.TaskUpdated(request.TaskID, fmt.Sprintf("%q was declined and left unassigned", request.TaskTitle), &userID))
+		return nil
+	}
+	if err := s.slaRepo.StartTaskSLAs(ctx, []string{request.TaskID}, []domain.SLAStart{domain.SLAStartAssigned}); err != nil {
+		return err
+	}
+	if err := s.watcherRepo.AutoWatchTasks(ctx, []string{request.TaskID}); err != nil {
+		return err
+	}
+	notify.Send(ctx, s.notifyRepo, notify.Assigned(request.TaskID, request.TaskTitle, *assigneeID, &userID),
+		notify.TaskUpdated(request.TaskID, fmt.Sprintf("%q was handed off", request.TaskTitle), &userID))
+	return nil
+}
+
+// RejectHandoff leaves the task with the requester
+func (s *service) RejectHandoff(ctx context.Context, requestID string, resolve domain.ResolveHandoffRequest, userID string) error {
+	request, _, err := s.resolvableRequest(ctx, requestID)
+	if err != nil {
+		return err
+	}
+	if resolve.AssigneeID != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Rejected requests do not change the assignee")
+	}
+	return s.handoffRepo.ResolveHandoffRequest(ctx, request, domain.HandoffRejected, strings.TrimSpace(resolve.Note), nil, userID)
+}
+
+// resolvableRequest returns a pending request, with its task, whose task is still assigned to the requester
+func (s *service) resolvableRequest(ctx context.Context, requestID string) (domain.HandoffRequest, domain.Task, error) {
+	request, err := s.handoffRepo.GetHandoffRequestByID(ctx, requestID)
+	if err != nil {
+		return domain.HandoffRequest{}, domain.Task{}, err
+	}
+	if request.Status != domain.HandoffPending {
+		return domain.HandoffRequest{}, domain.Task{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Handoff request is already resolved")
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, request.TaskID)
+	if err != nil {
+		return domain.HandoffRequest{}, domain.Task{}, err
+	}
+	if task.AssigneeID == nil || *task.AssigneeID != request.RequestedBy {
+		return domain.HandoffRequest{}, domain.Task{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "The task was reassigned since the request")
+	}
+	return request, task, nil
+}
+
+func (s *service) hasPendingRequest(ctx context.Context, taskID string) (bool, error) {
+	requests, err := s.handoffRepo.GetTaskHandoffRequests(ctx, taskID)
+	if err != nil {
+		return false, err
+	}
+	for _, request := range requests {
+		if request.Status == domain.HandoffPending {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkAssignee requires the new assignee to be an employee other than the requester
+func (s *service) checkAssignee(ctx context.Context, assigneeID, requestedBy string) error {
+	if assigneeID == requestedBy {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "The task must go to another employee")
+	}
+	assignee, err := s.userRepo.GetUserByID(ctx, assigneeID)
+	if err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Assignee not found")
+	}
+	if assignee.Role != domain.RoleEmployee {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Assignee must be an employee")
+	}
+	return nil
+}
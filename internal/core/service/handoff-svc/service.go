@@ -0,0 +1,20 @@
+package handoffsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	handoffRepo port.HandoffRepository
+	taskRepo    port.TaskRepository
+	userRepo    port.UserRepository
+	slaRepo     port.SLARepository
+	notifyRepo  port.NotificationRepository
+	watcherRepo port.WatcherRepository
+	// taskService checks the reassignment of approved handoffs as any assignment
+	taskService port.TaskService
+}
+
+func New(handoffRepo port.HandoffRepository, taskRepo port.TaskRepository, userRepo port.UserRepository, slaRepo port.SLARepository,
+	notifyRepo port.NotificationRepository, watcherRepo port.WatcherRepository, taskService port.TaskService) port.HandoffService {
+	return &service{handoffRepo: handoffRepo, taskRepo: taskRepo, userRepo: userRepo, slaRepo: slaRepo, notifyRepo: notifyRepo, watcherRepo: watcherRepo,
+		taskService: taskService}
+}
@@ -0,0 +1,170 @@
+package linksvc
+
+import (
+	"context"
+	"fmt"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/notify"
+	"kn-assignment/internal/log"
+)
+
+func (s *service) CreateTaskLink(ctx context.Context, taskID string, link domain.CreateTaskLinkRequest, userRole, userID string) (domain.TaskLink, error) {
+	if !link.Type.IsValid() {
+		return domain.TaskLink{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest,
+			"Type must be duplicate_of, relates_to, follow_up_of, caused_by or blocked_by")
+	}
+	if err := s.checkLinkedTasks(ctx, taskID, link.TargetTaskID, userRole, userID); err != nil {
+		return domain.TaskLink{}, err
+	}
+	if link.Type == domain.LinkBlockedBy {
+		// dependencies must not loop, for the timeline to order them
+		cycle, err := s.linkRepo.IsBlockedBy(ctx, link.TargetTaskID, taskID)
+		if err != nil {
+			return domain.TaskLink{}, err
+		}
+		if cycle {
+			return domain.TaskLink{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "The task to link to is already blocked by this task")
+		}
+	}
+
+	log.Infof(ctx, "Linking task %s as %s task %s", taskID, link.Type, link.TargetTaskID)
+	created, err := s.linkRepo.CreateTaskLink(ctx, taskID, link, userID)
+	if err != nil {
+		return domain.TaskLink{}, err
+	}
+	s.notifyLinkChange(ctx, created, "Link added", userID)
+	return created, nil
+}
+
+// GetTaskLinks returns the links of a task to employers and its assignee
+func (s *service) GetTaskLinks(ctx context.Context, taskID, userRole, userID string) ([]domain.LinkedTask, error) {
+	if _, err := s.getTask(ctx, taskID, userRole, userID); err != nil {
+		return nil, err
+	}
+	links, err := s.GetLinksByTaskIDs(ctx, []string{taskID}, userRole, userID)
+	if err != nil {
+		return nil, err
+	}
+	return links[taskID], nil
+}
+
+// GetLinksByTaskIDs returns the links of tasks the caller may already read, by task. Links
+// to tasks employees are not assigned to are left out, not to show their titles.
+func (s *service) GetLinksByTaskIDs(ctx context.Context, taskIDs []string, userRole, userID string) (map[string][]domain.LinkedTask, error) {
+	links, err := s.linkRepo.GetTaskLinks(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+	byTask := make(map[string][]domain.LinkedTask, len(taskIDs))
+	for _, link := range links {
+		if userRole != string(domain.RoleEmployer) && (link.AssigneeID == nil || *link.AssigneeID != userID) {
+			continue
+		}
+		link.SetLabel()
+		byTask[link.FromTaskID] = append(byTask[link.FromTaskID], link)
+	}
+	return byTask, nil
+}
+
+// DeleteTaskLink removes a link from either of its tasks
+func (s *service) DeleteTaskLink(ctx context.Context, taskID, linkID, userRole, userID string) error {
+	if _, err := s.getTask(ctx, taskID, userRole, userID); err != nil {
+		return err
+	}
+	link, err := s.linkRepo.GetTaskLinkByID(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	if link.SourceTaskID != taskID && link.TargetTaskID != taskID {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Task link not found")
+	}
+
+	log.Infof(ctx, "Removing link %s of task %s", linkID, taskID)
+	if err := s.linkRepo.DeleteTaskLink(ctx, linkID); err != nil {
+		return err
+	}
+	s.notifyLinkChange(ctx, link, "Link removed", userID)
+	return nil
+}
+
+// notifyLinkChange tells the watchers of both tasks of a link it was added or removed,
+// e.g. Link added: "A" duplicate of "B" and Link added: "B" duplicated by "A"
+func (s *service) notifyLinkChange(ctx context.Context, link domain.TaskLink, change, userID string) {
+	source, err := s.taskRepo.GetTaskByID(ctx, link.SourceTaskID)
+	if err != nil {
+		return
+	}
+	target, err := s.taskRepo.GetTaskByID(ctx, link.TargetTaskID)
+	if err != nil {
+		return
+	}
+	notify.Send(ctx, s.notifyRepo,
+		notify.TaskUpdated(source.ID, fmt.Sprintf("%s: %q %s %q", change, source.Title, link.Type.Label(), target.Title), &userID),
+		notify.TaskUpdated(target.ID, fmt.Sprintf("%s: %q %s %q", change, target.Title, link.Type.ReverseLabel(), source.Title), &userID))
+}
+
+// CloseAsDuplicate completes a task as a duplicate of the original task. Being a closing
+// rather than a completion of the work, it skips the review and the required checklist
+// items of the task, so only employers can do it.
+func (s *service) CloseAsDuplicate(ctx context.Context, taskID string, request domain.CloseAsDuplicateRequest, userRole, userID string) error {
+	if userRole != string(domain.RoleEmployer) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "Only employers can close tasks as duplicates")
+	}
+	if err := s.checkLinkedTasks(ctx, taskID, request.OriginalTaskID, userRole, userID); err != nil {
+		return err
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if task.Status == domain.StatusCompleted {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Task is already completed")
+	}
+
+	log.Infof(ctx, "Closing task %s as a duplicate of task %s", taskID, request.OriginalTaskID)
+	change := domain.TaskChange{Task: task, Status: domain.StatusCompleted, AssigneeID: task.AssigneeID, UserRole: userRole, UserID: userID}
+	err = s.taskService.CheckTaskChange(ctx, change, func(ctx context.Context) error {
+		if err := s.linkRepo.CloseAsDuplicate(ctx, taskID, request.OriginalTaskID, userID); err != nil {
+			return err
+		}
+		return s.taskRepo.RankLast(ctx, taskID, domain.StatusCompleted)
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.slaRepo.UpdateTaskSLAStatus(ctx, taskID, domain.StatusCompleted); err != nil {
+		return err
+	}
+	notify.Send(ctx, s.notifyRepo, notify.StatusChanged(task, domain.StatusCompleted, userID))
+	return nil
+}
+
+// checkLinkedTasks checks that the caller can link the task and that the other task exists
+func (s *service) checkLinkedTasks(ctx context.Context, taskID, otherTaskID, userRole, userID string) error {
+	if otherTaskID == "" {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "The task to link to is required")
+	}
+	if otherTaskID == taskID {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "A task cannot be linked to itself")
+	}
+	if _, err := s.getTask(ctx, taskID, userRole, userID); err != nil {
+		return err
+	}
+	_, err := s.taskRepo.GetTaskByID(ctx, otherTaskID)
+	return err
+}
+
+// getTask returns a task for employers and its assignee
+func (s *service) getTask(ctx context.Context, taskID, userRole, userID string) (domain.Task, error) {
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if userRole != string(domain.RoleEmployer) && (task.AssigneeID == nil || *task.AssigneeID != userID) {
+		return domain.Task{}, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only access the links of tasks assigned to you")
+	}
+	return task, nil
+}
@@ -0,0 +1,17 @@
+package linksvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	linkRepo   port.LinkRepository
+	taskRepo   port.TaskRepository
+	slaRepo    port.SLARepository
+	notifyRepo port.NotificationRepository
+	// taskService checks the WIP limits of the tasks closed as duplicates
+	taskService port.TaskService
+}
+
+func New(linkRepo port.LinkRepository, taskRepo port.TaskRepository, slaRepo port.SLARepository, notifyRepo port.NotificationRepository,
+	taskService port.TaskService) port.LinkService {
+	return &service{linkRepo: linkRepo, taskRepo: taskRepo, slaRepo: slaRepo, notifyRepo: notifyRepo, taskService: taskService}
+}
@@ -0,0 +1,116 @@
+package notificationsvc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/notify"
+	"kn-assignment/internal/log"
+)
+
+const (
+	emailBatch       = 100
+	maxEmailAttempts = 3
+	// emailMaxAge is how long a notification waits to be emailed, older ones are dropped
+	// rather than sent late, for instance when email was not configured yet
+	emailMaxAge = 24 * time.Hour
+)
+
+// SendEmails emails the pending notifications, then the digests due. Sending stops at the first
+// failure, as the mail server is likely unavailable, and is retried on the next run.
+func (s *service) SendEmails(ctx context.Context) error {
+	if s.mailer == nil {
+		return nil
+	}
+	if err := s.sendNotificationEmails(ctx); err != nil {
+		return err
+	}
+	return s.sendDigests(ctx)
+}
+
+func (s *service) sendNotificationEmails(ctx context.Context) error {
+	pending, err := s.notificationRepo.GetPendingEmails(ctx, time.Now().Add(-emailMaxAge), emailBatch)
+	if err != nil {
+		return err
+	}
+	sent := 0
+	for _, notification := range pending {
+		email, err := notify.NotificationEmail(notification)
+		if err != nil {
+			log.Errorf(ctx, "Error rendering the email of notification %s: %s", notification.ID, err.Error())
+			if err := s.notificationRepo.MarkEmailFailed(ctx, notification.ID, 0); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := s.mailer.Send(ctx, email); err != nil {
+			log.Warningf(ctx, "Error emailing notification %s: %s", notification.ID, err.Error())
+			return s.notificationRepo.MarkEmailFailed(ctx, notification.ID, maxEmailAttempts)
+		}
+		if err := s.notificationRepo.MarkEmailSent(ctx, notification.ID); err != nil {
+			return err
+		}
+		sent++
+	}
+	if sent > 0 {
+		log.Infof(ctx, "%d notification emails sent", sent)
+	}
+	return nil
+}
+
+// sendDigests emails their open and overdue tasks to the employees whose digest is due.
+// Employees without open tasks get no digest that day.
+func (s *service) sendDigests(ctx context.Context) error {
+	recipients, err := s.notificationRepo.GetDigestRecipients(ctx, s.digestHour)
+	if err != nil {
+		return err
+	}
+	sent := 0
+	for _, user := range recipients {
+		loc, err := domain.LoadTimezone(user.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		now := time.Now().In(loc)
+		day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+		tasks, err := s.taskRepo.GetTasksByAssignee(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		digest := domain.Digest{Username: user.Username, Date: now.Format(time.DateOnly)}
+		sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].DueDate.Before(tasks[j].DueDate) })
+		for _, task := range tasks {
+			if task.Status == domain.StatusCompleted {
+				continue
+			}
+			task.DueDate = task.DueDate.In(loc)
+			if task.DueDate.Before(now) {
+				digest.Overdue = append(digest.Overdue, task)
+			} else {
+				digest.Open = append(digest.Open, task)
+			}
+		}
+
+		if len(digest.Overdue)+len(digest.Open) > 0 {
+			email, err := notify.DigestEmail(*user.Email, digest)
+			if err != nil {
+				return err
+			}
+			if err := s.mailer.Send(ctx, email); err != nil {
+				log.Warningf(ctx, "Error emailing the digest of %s: %s", user.ID, err.Error())
+				return nil
+			}
+			sent++
+		}
+		if err := s.notificationRepo.MarkDigestSent(ctx, user.ID, day); err != nil {
+			return err
+		}
+	}
+	if sent > 0 {
+		log.Infof(ctx, "%d digests sent", sent)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package notificationsvc
+
+import (
+	"context"
+	"time"
+
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/log"
+)
+
+const (
+	defaultNotifications = 50
+	maxNotifications     = 200
+	// overdueWithin is how long after their due date overdue tasks are still notified,
+	// older ones are left to the digest
+	overdueWithin = 24 * time.Hour
+)
+
+// GetNotifications returns the latest notifications of a user with their unread count
+func (s *service) GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) (domain.NotificationInbox, error) {
+	if limit <= 0 {
+		limit = defaultNotifications
+	}
+	limit = min(limit, maxNotifications)
+
+	notifications, err := s.notificationRepo.GetNotifications(ctx, userID, unreadOnly, limit)
+	if err != nil {
+		return domain.NotificationInbox{}, err
+	}
+	unread, err := s.notificationRepo.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return domain.NotificationInbox{}, err
+	}
+	if notifications == nil {
+		notifications = []domain.Notification{}
+	}
+	return domain.NotificationInbox{UnreadCount: unread, Notifications: notifications}, nil
+}
+
+func (s *service) MarkNotificationRead(ctx context.Context, notificationID, userID string) error {
+	return s.notificationRepo.MarkNotificationRead(ctx, notificationID, userID)
+}
+
+func (s *service) MarkAllNotificationsRead(ctx context.Context, userID string) (int64, error) {
+	return s.notificationRepo.MarkAllNotificationsRead(ctx, userID)
+}
+
+// CheckNotifications notifies the assignees of tasks due soon or overdue and deletes the notifications
+// past their retention
+func (s *service) CheckNotifications(ctx context.Context) error {
+	if s.dueSoon > 0 {
+		created, err := s.notificationRepo.CreateDueSoonNotifications(ctx, s.dueSoon)
+		if err != nil {
+			return err
+		}
+		if created > 0 {
+			log.Infof(ctx, "%d due soon notifications created", created)
+		}
+	}
+	overdue, err := s.notificationRepo.CreateOverdueNotifications(ctx, overdueWithin)
+	if err != nil {
+		return err
+	}
+	if overdue > 0 {
+		log.Infof(ctx, "%d overdue notifications created", overdue)
+	}
+	if s.retention > 0 {
+		deleted, err := s.notificationRepo.DeleteNotificationsBefore(ctx, time.Now().Add(-s.retention))
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			log.Infof(ctx, "%d notifications past retention deleted", deleted)
+		}
+	}
+	return nil
+}
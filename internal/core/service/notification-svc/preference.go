@@ -0,0 +1,56 @@
+package notificationsvc
+
+import (
+	"context"
+	"fmt"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+)
+
+// GetNotificationPreferences returns the channels of every notification type for a user,
+// with the default channels for the types the user has not set
+func (s *service) GetNotificationPreferences(ctx context.Context, userID string) ([]domain.NotificationPreference, error) {
+	stored, err := s.notificationRepo.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	byType := make(map[domain.NotificationType]domain.NotificationPreference, len(stored))
+	for _, preference := range stored {
+		byType[preference.Type] = preference
+	}
+	preferences := make([]domain.NotificationPreference, 0, len(domain.NotificationTypes))
+	for _, t := range domain.NotificationTypes {
+		preference, ok := byType[t]
+		if !ok {
+			preference = domain.DefaultNotificationPreference(t)
+		}
+		preferences = append(preferences, preference)
+	}
+	return preferences, nil
+}
+
+// UpdateNotificationPreferences sets the channels of the given notification types, leaving the others as they are
+func (s *service) UpdateNotificationPreferences(ctx context.Context, userID string, preferences []domain.NotificationPreference) ([]domain.NotificationPreference, error) {
+	if len(preferences) == 0 {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "At least one preference is required")
+	}
+	seen := make(map[domain.NotificationType]bool, len(preferences))
+	for _, preference := range preferences {
+		if !preference.Type.IsValid() {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Unknown notification type %q", preference.Type))
+		}
+		if seen[preference.Type] {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Notification type %q is set twice", preference.Type))
+		}
+		if preference.Type == domain.NotificationDigest && preference.InApp {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "The digest is only sent by email")
+		}
+		seen[preference.Type] = true
+	}
+	if err := s.notificationRepo.UpsertNotificationPreferences(ctx, userID, preferences); err != nil {
+		return nil, err
+	}
+	return s.GetNotificationPreferences(ctx, userID)
+}
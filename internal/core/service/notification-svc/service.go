@@ -0,0 +1,32 @@
+package notificationsvc
+
+import (
+	"time"
+
+	"kn-assignment/internal/core/port"
+)
+
+type service struct {
+	notificationRepo port.NotificationRepository
+	taskRepo         port.TaskRepository
+	// mailer sends notification emails and digests, nil when email is not configured
+	mailer port.Mailer
+	// dueSoon is how long before their due date assignees are notified, 0 to never notify
+	dueSoon time.Duration
+	// retention is how long notifications are kept, 0 to keep them forever
+	retention time.Duration
+	// digestHour is the hour of the day, in the timezone of each employee, their digest is sent from
+	digestHour int
+}
+
+func New(notificationRepo port.NotificationRepository, taskRepo port.TaskRepository, mailer port.Mailer,
+	dueSoon, retention time.Duration, digestHour int) port.NotificationService {
+	return &service{
+		notificationRepo: notificationRepo,
+		taskRepo:         taskRepo,
+		mailer:           mailer,
+		dueSoon:          dueSoon,
+		retention:        retention,
+		digestHour:       digestHour,
+	}
+}
@@ -0,0 +1,95 @@
+package poolsvc
+
+import (
+	"context"
+	"fmt"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/notify"
+	"kn-assignment/internal/log"
+)
+
+func (s *service) GetPoolTasks(ctx context.Context) ([]domain.Task, error) {
+	return s.poolRepo.GetPoolTasks(ctx)
+}
+
+// PublishTask opens an unassigned task to claims
+func (s *service) PublishTask(ctx context.Context, taskID, userID string) error {
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if task.AssigneeID != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Only unassigned tasks can be published to the pool")
+	}
+	if task.Status == domain.StatusCompleted {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Completed tasks cannot be published to the pool")
+	}
+
+	log.Infof(ctx, "Publishing task %s to the pool", taskID)
+	return s.poolRepo.SetTaskPooled(ctx, taskID, true, userID)
+}
+
+// WithdrawTask removes a task from the pool. A claimed task stays with its assignee.
+func (s *service) WithdrawTask(ctx context.Context, taskID, userID string) error {
+	if _, err := s.taskRepo.GetTaskByID(ctx, taskID); err != nil {
+		return err
+	}
+
+	log.Infof(ctx, "Withdrawing task %s from the pool", taskID)
+	return s.poolRepo.SetTaskPooled(ctx, taskID, false, userID)
+}
+
+// ClaimTask assigns an open pool task to the employee claiming it, within their claim limit
+func (s *service) ClaimTask(ctx context.Context, taskID string, request domain.ClaimTaskRequest, userRole, userID string) error {
+	if userRole != string(domain.RoleEmployee) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "Only employees can claim tasks")
+	}
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "User not found")
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	limit := s.claimLimit
+	if user.ClaimLimit != nil {
+		limit = *user.ClaimLimit
+	}
+
+	// the claim counts against the WIP limits of the employee as any assignment, in the same transaction
+	change := domain.TaskChange{Task: task, Status: task.Status, AssigneeID: &userID, AllowTimeOff: request.AllowTimeOff,
+		UserRole: userRole, UserID: userID}
+	err = s.taskService.CheckTaskChange(ctx, change, func(ctx context.Context) error {
+		return s.poolRepo.ClaimTask(ctx, taskID, userID, limit)
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof(ctx, "Task %s claimed by %s", taskID, userID)
+	if err := s.slaRepo.StartTaskSLAs(ctx, []string{taskID}, []domain.SLAStart{domain.SLAStartAssigned}); err != nil {
+		return err
+	}
+	if err := s.watcherRepo.AutoWatchTasks(ctx, []string{taskID}); err != nil {
+		return err
+	}
+	notify.Send(ctx, s.notifyRepo, notify.TaskUpdated(taskID, fmt.Sprintf("%q was claimed by %s", task.Title, user.Username), &userID))
+	return nil
+}
+
+// ReleaseTask gives a task the employee claimed back to the pool
+func (s *service) ReleaseTask(ctx context.Context, taskID, userID string) error {
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if err := s.poolRepo.ReleaseTask(ctx, taskID, userID); err != nil {
+		return err
+	}
+	log.Infof(ctx, "Task %s released to the pool by %s", taskID, userID)
+	notify.Send(ctx, s.notifyRepo, notify.TaskUpdated(taskID, fmt.Sprintf("%q was released to the pool", task.Title), &userID))
+	return nil
+}
@@ -0,0 +1,31 @@
+package poolsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	poolRepo port.PoolRepository
+	taskRepo port.TaskRepository
+	userRepo port.UserRepository
+	slaRepo  port.SLARepository
+	// notifyRepo and watcherRepo tell the watchers of pool tasks who claims them
+	notifyRepo  port.NotificationRepository
+	watcherRepo port.WatcherRepository
+	// taskService checks claims as any assignment
+	taskService port.TaskService
+	// claimLimit is the number of pool tasks an employee can hold unless overridden, 0 for no limit
+	claimLimit int
+}
+
+func New(poolRepo port.PoolRepository, taskRepo port.TaskRepository, userRepo port.UserRepository, slaRepo port.SLARepository,
+	notifyRepo port.NotificationRepository, watcherRepo port.WatcherRepository, taskService port.TaskService, claimLimit int) port.PoolService {
+	return &service{
+		poolRepo:    poolRepo,
+		taskRepo:    taskRepo,
+		userRepo:    userRepo,
+		slaRepo:     slaRepo,
+		notifyRepo:  notifyRepo,
+		watcherRepo: watcherRepo,
+		taskService: taskService,
+		claimLimit:  claimLimit,
+	}
+}
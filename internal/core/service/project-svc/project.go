@@ -0,0 +1,55 @@
+package projectsvc
+
+import (
+	"context"
+	"strings"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+)
+
+func (s *service) CreateProject(ctx context.Context, project domain.CreateProjectRequest) (domain.Project, error) {
+	project.Key = strings.ToUpper(strings.TrimSpace(project.Key))
+	project.Name = strings.TrimSpace(project.Name)
+	if !domain.IsValidKeyPrefix(project.Key) {
+		log.Infof(ctx, "Invalid project key %q", project.Key)
+		return domain.Project{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Key must be 2 to 10 letters or digits, starting with a letter")
+	}
+	if project.Name == "" {
+		return domain.Project{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+	}
+	if _, err := s.projectRepo.GetProjectByKey(ctx, project.Key); err == nil {
+		return domain.Project{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "A project with this key already exists")
+	}
+	return s.projectRepo.CreateProject(ctx, project)
+}
+
+func (s *service) GetProject(ctx context.Context, projectID string) (domain.Project, error) {
+	if projectID == "" {
+		return domain.Project{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Project ID is required")
+	}
+	return s.projectRepo.GetProjectByID(ctx, projectID)
+}
+
+func (s *service) GetProjects(ctx context.Context) ([]domain.Project, error) {
+	return s.projectRepo.GetProjects(ctx)
+}
+
+func (s *service) UpdateProject(ctx context.Context, projectID string, project domain.UpdateProjectRequest) error {
+	if project.Name == nil && project.ReviewRequired == nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Nothing to update")
+	}
+	if project.Name != nil {
+		name := strings.TrimSpace(*project.Name)
+		if name == "" {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+		}
+		project.Name = &name
+	}
+	if _, err := s.projectRepo.GetProjectByID(ctx, projectID); err != nil {
+		return err
+	}
+	return s.projectRepo.UpdateProject(ctx, projectID, project)
+}
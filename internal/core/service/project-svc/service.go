@@ -0,0 +1,11 @@
+package projectsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	projectRepo port.ProjectRepository
+}
+
+func New(projectRepo port.ProjectRepository) port.ProjectService {
+	return &service{projectRepo: projectRepo}
+}
@@ -0,0 +1,67 @@
+package reviewsvc
+
+import (
+	"context"
+	"strings"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/notify"
+	"kn-assignment/internal/log"
+)
+
+// ReviewTask lets the creator of a task in review accept it or send it back with feedback
+func (s *service) ReviewTask(ctx context.Context, taskID string, review domain.ReviewTaskRequest, userID string) (domain.TaskReview, error) {
+	review.Feedback = strings.TrimSpace(review.Feedback)
+	if !review.Approved && review.Feedback == "" {
+		return domain.TaskReview{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Feedback is required to send a task back")
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return domain.TaskReview{}, err
+	}
+	if task.CreatedBy != userID {
+		return domain.TaskReview{}, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "Only the creator of a task can review it")
+	}
+	if task.Status != domain.StatusInReview {
+		return domain.TaskReview{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Task is not awaiting review")
+	}
+
+	status := domain.StatusInProgress
+	if review.Approved {
+		status = domain.StatusCompleted
+	}
+	// a task sent back counts again against the WIP limits of its assignee, which the
+	// reviewing employer can override
+	var created domain.TaskReview
+	change := domain.TaskChange{Task: task, Status: status, AssigneeID: task.AssigneeID, OverrideNote: review.OverrideNote,
+		UserRole: string(domain.RoleEmployer), UserID: userID}
+	err = s.taskService.CheckTaskChange(ctx, change, func(ctx context.Context) error {
+		if created, err = s.reviewRepo.CreateTaskReview(ctx, task, review, userID); err != nil {
+			return err
+		}
+		return s.taskRepo.RankLast(ctx, taskID, status)
+	})
+	if err != nil {
+		return domain.TaskReview{}, err
+	}
+	log.Infof(ctx, "Task %s reviewed by %s, moved to %s", taskID, userID, status)
+	if err := s.slaRepo.UpdateTaskSLAStatus(ctx, taskID, status); err != nil {
+		return domain.TaskReview{}, err
+	}
+	notify.Send(ctx, s.notifyRepo, append(notify.Reviewed(task, created), notify.StatusChanged(task, status, userID))...)
+	return created, nil
+}
+
+// GetTaskReviews returns the reviews of a task to employers and its assignee
+func (s *service) GetTaskReviews(ctx context.Context, taskID, userRole, userID string) ([]domain.TaskReview, error) {
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if userRole != string(domain.RoleEmployer) && (task.AssigneeID == nil || *task.AssigneeID != userID) {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only access the reviews of tasks assigned to you")
+	}
+	return s.reviewRepo.GetTaskReviews(ctx, taskID)
+}
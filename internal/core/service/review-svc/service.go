@@ -0,0 +1,17 @@
+package reviewsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	reviewRepo port.ReviewRepository
+	taskRepo   port.TaskRepository
+	slaRepo    port.SLARepository
+	notifyRepo port.NotificationRepository
+	// taskService checks the WIP limits of the status a task is reviewed to
+	taskService port.TaskService
+}
+
+func New(reviewRepo port.ReviewRepository, taskRepo port.TaskRepository, slaRepo port.SLARepository, notifyRepo port.NotificationRepository,
+	taskService port.TaskService) port.ReviewService {
+	return &service{reviewRepo: reviewRepo, taskRepo: taskRepo, slaRepo: slaRepo, notifyRepo: notifyRepo, taskService: taskService}
+}
@@ -0,0 +1,115 @@
+package slasvc
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/assignment"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/notify"
+	"kn-assignment/internal/log"
+)
+
+// CheckSLAs marks the targets breached since the last check, then escalates the
+// tasks about to breach. A failed escalation is retried on the next check.
+func (s *service) CheckSLAs(ctx context.Context) error {
+	breached, err := s.slaRepo.MarkSLABreaches(ctx)
+	if err != nil {
+		return err
+	}
+	if breached > 0 {
+		log.Warningf(ctx, "%d task SLAs breached", breached)
+	}
+
+	candidates, err := s.slaRepo.GetSLAEscalations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, candidate := range candidates {
+		escalatedTo, err := s.escalate(ctx, candidate)
+		if err != nil {
+			log.Errorf(ctx, "Error escalating SLA of task %s: %s", candidate.TaskID, err.Error())
+			continue
+		}
+		if err := s.slaRepo.MarkSLAEscalated(ctx, candidate.TaskID, escalatedTo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escalate reassigns or notifies for a task about to breach and returns the user it was escalated to.
+// Tasks are escalated to their creator when there is no other employee to reassign them to.
+func (s *service) escalate(ctx context.Context, candidate domain.SLAEscalationCandidate) (*string, error) {
+	if candidate.Escalation == domain.EscalationReassign {
+		assigneeID, err := s.reassign(ctx, candidate)
+		if err != nil || assigneeID != nil {
+			return assigneeID, err
+		}
+	}
+	log.Warningf(ctx, "SLA of task %q (%s) is about to be breached, notifying employer %s", candidate.TaskTitle, candidate.TaskID, candidate.CreatedBy)
+	escalation := domain.CreateNotificationRequest{
+		UserID:  candidate.CreatedBy,
+		Type:    domain.NotificationSLAEscalated,
+		TaskID:  &candidate.TaskID,
+		Message: fmt.Sprintf("The SLA of %q is about to be breached", candidate.TaskTitle),
+	}
+	watchers := escalation
+	watchers.UserID, watchers.Watchers = "", true
+	notify.Send(ctx, s.notifyRepo, escalation, watchers)
+	return &candidate.CreatedBy, nil
+}
+
+// reassign gives a task to the least loaded employee other than its assignee, within their WIP
+// limit as with any assignment, leaving out the employees off today or on the day the task is due.
+// It returns nil when there is no other employee to give it to.
+func (s *service) reassign(ctx context.Context, candidate domain.SLAEscalationCandidate) (*string, error) {
+	task, err := s.taskRepo.GetTaskByID(ctx, candidate.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := s.taskRepo.GetWorkloads(ctx)
+	if err != nil {
+		return nil, err
+	}
+	days := []time.Time{time.Now()}
+	if !task.DueDate.IsZero() {
+		days = append(days, task.DueDate)
+	}
+	off, err := s.availabilityRepo.GetUsersOff(ctx, days)
+	if err != nil {
+		return nil, err
+	}
+	if candidate.AssigneeID != nil {
+		off = append(off, *candidate.AssigneeID)
+	}
+	others := assignment.Without(workloads, off...)
+	picked, ok := assignment.Pick(domain.StrategyLeastLoaded, others, candidate.Labels)
+	if !ok {
+		return nil, nil
+	}
+	// escalations cannot override WIP limits
+	change := domain.TaskChange{Task: task, Status: task.Status, AssigneeID: &picked.UserID}
+	err = s.taskService.CheckTaskChange(ctx, change, func(ctx context.Context) error {
+		return s.taskRepo.AssignTask(ctx, candidate.TaskID, picked.UserID)
+	})
+	var customErr *errors.CustomError
+	if stderrors.As(err, &customErr) && customErr.Code == constant.ErrCodeConflict {
+		log.Infof(ctx, "Task %s cannot be reassigned to %s: %s", candidate.TaskID, picked.Username, customErr.Message)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Warningf(ctx, "SLA of task %q (%s) is about to be breached, reassigned to %s", candidate.TaskTitle, candidate.TaskID, picked.Username)
+	if err := s.watcherRepo.AutoWatchTasks(ctx, []string{candidate.TaskID}); err != nil {
+		return nil, err
+	}
+	notify.Send(ctx, s.notifyRepo, notify.Assigned(candidate.TaskID, candidate.TaskTitle, picked.UserID, nil),
+		notify.TaskUpdated(candidate.TaskID, fmt.Sprintf("%q was reassigned to %s as its SLA is about to be breached", candidate.TaskTitle, picked.Username), nil))
+	return &picked.UserID, nil
+}
@@ -0,0 +1,21 @@
+package slasvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	slaRepo     port.SLARepository
+	taskRepo    port.TaskRepository
+	projectRepo port.ProjectRepository
+	notifyRepo  port.NotificationRepository
+	watcherRepo port.WatcherRepository
+	// availabilityRepo leaves employees on time off out of reassignments
+	availabilityRepo port.AvailabilityRepository
+	// taskService checks the reassignments of escalations as any assignment
+	taskService port.TaskService
+}
+
+func New(slaRepo port.SLARepository, taskRepo port.TaskRepository, projectRepo port.ProjectRepository, notifyRepo port.NotificationRepository,
+	watcherRepo port.WatcherRepository, availabilityRepo port.AvailabilityRepository, taskService port.TaskService) port.SLAService {
+	return &service{slaRepo: slaRepo, taskRepo: taskRepo, projectRepo: projectRepo, notifyRepo: notifyRepo, watcherRepo: watcherRepo,
+		availabilityRepo: availabilityRepo, taskService: taskService}
+}
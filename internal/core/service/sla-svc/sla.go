@@ -0,0 +1,99 @@
+package slasvc
+
+import (
+	"context"
+	"strings"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+)
+
+func (s *service) CreateSLAPolicy(ctx context.Context, policy domain.CreateSLAPolicyRequest) (domain.SLAPolicy, error) {
+	policy.Name = strings.TrimSpace(policy.Name)
+	policy.Labels = domain.NormalizeLabels(policy.Labels)
+	if policy.StartOn == "" {
+		policy.StartOn = domain.SLAStartCreated
+	}
+	if policy.Escalation == "" {
+		policy.Escalation = domain.EscalationNotify
+	}
+	if policy.Name == "" {
+		return domain.SLAPolicy{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+	}
+	if !policy.StartOn.IsValid() {
+		return domain.SLAPolicy{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Start on must be created or assigned")
+	}
+	if policy.Priority != nil && !policy.Priority.IsValid() {
+		return domain.SLAPolicy{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Priority must be low, medium, high or urgent")
+	}
+	if policy.ResponseMinutes == nil && policy.ResolutionMinutes == nil {
+		return domain.SLAPolicy{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "A response or resolution target is required")
+	}
+	if err := validateTargets(policy.ResponseMinutes, policy.ResolutionMinutes, &policy.WarnBeforeMinutes, &policy.Escalation); err != nil {
+		return domain.SLAPolicy{}, err
+	}
+	if policy.ProjectID != nil {
+		if _, err := s.projectRepo.GetProjectByID(ctx, *policy.ProjectID); err != nil {
+			return domain.SLAPolicy{}, err
+		}
+	}
+	return s.slaRepo.CreateSLAPolicy(ctx, policy)
+}
+
+func (s *service) GetSLAPolicies(ctx context.Context) ([]domain.SLAPolicy, error) {
+	return s.slaRepo.GetSLAPolicies(ctx)
+}
+
+func (s *service) UpdateSLAPolicy(ctx context.Context, policyID string, policy domain.UpdateSLAPolicyRequest) error {
+	if policy.Name == nil && policy.Precedence == nil && policy.ResponseMinutes == nil && policy.ResolutionMinutes == nil &&
+		policy.WarnBeforeMinutes == nil && policy.Escalation == nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Nothing to update")
+	}
+	if policy.Name != nil {
+		name := strings.TrimSpace(*policy.Name)
+		if name == "" {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+		}
+		policy.Name = &name
+	}
+	if err := validateTargets(policy.ResponseMinutes, policy.ResolutionMinutes, policy.WarnBeforeMinutes, policy.Escalation); err != nil {
+		return err
+	}
+	if _, err := s.slaRepo.GetSLAPolicyByID(ctx, policyID); err != nil {
+		return err
+	}
+	return s.slaRepo.UpdateSLAPolicy(ctx, policyID, policy)
+}
+
+func (s *service) DeleteSLAPolicy(ctx context.Context, policyID string) error {
+	if _, err := s.slaRepo.GetSLAPolicyByID(ctx, policyID); err != nil {
+		return err
+	}
+	return s.slaRepo.DeleteSLAPolicy(ctx, policyID)
+}
+
+func (s *service) GetTaskSLA(ctx context.Context, taskID, userRole, userID string) (domain.TaskSLA, error) {
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return domain.TaskSLA{}, err
+	}
+	if userRole != string(domain.RoleEmployer) && (task.AssigneeID == nil || *task.AssigneeID != userID) {
+		return domain.TaskSLA{}, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only access the SLA of tasks assigned to you")
+	}
+	return s.slaRepo.GetTaskSLA(ctx, taskID)
+}
+
+// validateTargets checks the given targets and escalation settings, nil values being left unchanged
+func validateTargets(responseMinutes, resolutionMinutes, warnBeforeMinutes *int, escalation *domain.SLAEscalation) error {
+	if (responseMinutes != nil && *responseMinutes <= 0) || (resolutionMinutes != nil && *resolutionMinutes <= 0) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Targets must be a positive number of minutes")
+	}
+	if warnBeforeMinutes != nil && *warnBeforeMinutes < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Warn before minutes cannot be negative")
+	}
+	if escalation != nil && !escalation.IsValid() {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Escalation must be notify or reassign")
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package sprintsvc
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+)
+
+// GetSprintBurndown replays the scope and status history of a sprint to give its scope and
+// completed work at the end of each of its days in a timezone, the timezone of the user by default.
+// A task counts as completed while its status is Completed, and with its current story points.
+func (s *service) GetSprintBurndown(ctx context.Context, sprintID, timezone, userID string) (domain.SprintBurndown, error) {
+	if timezone == "" {
+		user, err := s.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return domain.SprintBurndown{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "User not found")
+		}
+		timezone = user.Timezone
+	}
+	loc, err := domain.LoadTimezone(timezone)
+	if err != nil {
+		return domain.SprintBurndown{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Timezone must be an IANA timezone such as Europe/Paris")
+	}
+
+	sprint, err := s.sprintRepo.GetSprintByID(ctx, sprintID)
+	if err != nil {
+		return domain.SprintBurndown{}, err
+	}
+	scope, err := s.sprintRepo.GetSprintScopeChanges(ctx, sprintID)
+	if err != nil {
+		return domain.SprintBurndown{}, err
+	}
+	tasks, err := s.sprintRepo.GetSprintScopeTasks(ctx, sprintID)
+	if err != nil {
+		return domain.SprintBurndown{}, err
+	}
+	taskIDs := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		taskIDs = append(taskIDs, task.ID)
+	}
+	var statuses []domain.TaskStatusChange
+	if len(taskIDs) > 0 {
+		if statuses, err = s.taskRepo.GetTaskStatusChanges(ctx, taskIDs); err != nil {
+			return domain.SprintBurndown{}, err
+		}
+	}
+	h := newHistory(tasks, scope, statuses)
+
+	start, err := time.ParseInLocation(time.DateOnly, sprint.StartDate, loc)
+	if err != nil {
+		return domain.SprintBurndown{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	end, err := time.ParseInLocation(time.DateOnly, sprint.EndDate, loc)
+	if err != nil {
+		return domain.SprintBurndown{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	// the sprint is replayed up to now, or up to its close
+	cutoff := time.Now()
+	if sprint.ClosedAt != nil && sprint.ClosedAt.Before(cutoff) {
+		cutoff = *sprint.ClosedAt
+	}
+	// the ideal line starts from the scope of the first day, or of the start of a sprint started late
+	baseline := start
+	if sprint.StartedAt != nil && sprint.StartedAt.After(baseline) {
+		baseline = *sprint.StartedAt
+	}
+	if baseline.After(cutoff) {
+		baseline = cutoff
+	}
+	_, committed, _, _ := h.at(baseline)
+
+	burndown := domain.SprintBurndown{SprintID: sprint.ID, Timezone: loc.String()}
+	days := 0
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		days++
+	}
+	for i, day := 0, start; !day.After(end); i, day = i+1, day.AddDate(0, 0, 1) {
+		bd := domain.BurndownDay{
+			Date:                 day.Format(time.DateOnly),
+			IdealRemainingPoints: round(committed * float64(days-i-1) / float64(days)),
+		}
+		if day.Before(cutoff) {
+			// the end is the local midnight after the day, which is not always 24 hours later
+			at := day.AddDate(0, 0, 1)
+			if at.After(cutoff) {
+				at = cutoff
+			}
+			scopeTasks, scopePoints, completedTasks, completedPoints := h.at(at)
+			remaining := round(scopePoints - completedPoints)
+			scopePoints, completedPoints = round(scopePoints), round(completedPoints)
+			bd.ScopeTasks, bd.ScopePoints = &scopeTasks, &scopePoints
+			bd.CompletedTasks, bd.CompletedPoints = &completedTasks, &completedPoints
+			bd.RemainingPoints = &remaining
+		}
+		burndown.Days = append(burndown.Days, bd)
+	}
+	return burndown, nil
+}
+
+// history holds the scope and status changes of the tasks of a sprint, oldest first
+type history struct {
+	tasks    []domain.Task
+	scope    []domain.SprintScopeChange
+	statuses map[string][]domain.TaskStatusChange
+}
+
+func newHistory(tasks []domain.Task, scope []domain.SprintScopeChange, statuses []domain.TaskStatusChange) history {
+	h := history{tasks: tasks, scope: scope, statuses: make(map[string][]domain.TaskStatusChange)}
+	for _, change := range statuses {
+		h.statuses[change.TaskID] = append(h.statuses[change.TaskID], change)
+	}
+	return h
+}
+
+// at returns the tasks and points in the sprint just before a time, and of them the completed ones
+func (h history) at(t time.Time) (scopeTasks int, scopePoints float64, completedTasks int, completedPoints float64) {
+	in := make(map[string]bool)
+	for _, change := range h.scope {
+		if !change.ChangedAt.Before(t) {
+			break
+		}
+		in[change.TaskID] = change.Added
+	}
+	for _, task := range h.tasks {
+		if !in[task.ID] {
+			continue
+		}
+		points := 0.0
+		if task.StoryPoints != nil {
+			points = *task.StoryPoints
+		}
+		scopeTasks++
+		scopePoints += points
+		if h.statusAt(task, t) == domain.StatusCompleted {
+			completedTasks++
+			completedPoints += points
+		}
+	}
+	return scopeTasks, scopePoints, completedTasks, completedPoints
+}
+
+// statusAt returns the status of a task just before a time. Tasks start Pending, and keep
+// their current status when their history is empty.
+func (h history) statusAt(task domain.Task, t time.Time) domain.TaskStatus {
+	changes := h.statuses[task.ID]
+	if len(changes) == 0 {
+		return task.Status
+	}
+	status := domain.StatusPending
+	if changes[0].FromStatus != nil {
+		status = *changes[0].FromStatus
+	}
+	for _, change := range changes {
+		if !change.ChangedAt.Before(t) {
+			break
+		}
+		status = change.ToStatus
+	}
+	return status
+}
+
+func round(points float64) float64 {
+	return math.Round(points*100) / 100
+}
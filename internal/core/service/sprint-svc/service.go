@@ -0,0 +1,13 @@
+package sprintsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	sprintRepo port.SprintRepository
+	taskRepo   port.TaskRepository
+	userRepo   port.UserRepository
+}
+
+func New(sprintRepo port.SprintRepository, taskRepo port.TaskRepository, userRepo port.UserRepository) port.SprintService {
+	return &service{sprintRepo: sprintRepo, taskRepo: taskRepo, userRepo: userRepo}
+}
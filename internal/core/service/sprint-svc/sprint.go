@@ -0,0 +1,203 @@
+package sprintsvc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+)
+
+// maxSprintDays bounds the length of a sprint, whose burndown lists every day
+const maxSprintDays = 366
+
+func (s *service) CreateSprint(ctx context.Context, sprint domain.CreateSprintRequest, userID string) (domain.Sprint, error) {
+	sprint.Name = strings.TrimSpace(sprint.Name)
+	sprint.Goal = strings.TrimSpace(sprint.Goal)
+	if sprint.Name == "" {
+		return domain.Sprint{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+	}
+	if err := validateDates(sprint.StartDate, sprint.EndDate); err != nil {
+		log.Infof(ctx, "Invalid sprint dates %q to %q", sprint.StartDate, sprint.EndDate)
+		return domain.Sprint{}, err
+	}
+	return s.sprintRepo.CreateSprint(ctx, sprint, userID)
+}
+
+func (s *service) GetSprint(ctx context.Context, sprintID string) (domain.Sprint, error) {
+	if sprintID == "" {
+		return domain.Sprint{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Sprint ID is required")
+	}
+	return s.sprintRepo.GetSprintByID(ctx, sprintID)
+}
+
+func (s *service) GetSprints(ctx context.Context, status domain.SprintStatus) ([]domain.Sprint, error) {
+	if status != "" && !status.IsValid() {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Status must be planned, active or closed")
+	}
+	return s.sprintRepo.GetSprints(ctx, status)
+}
+
+func (s *service) UpdateSprint(ctx context.Context, sprintID string, sprint domain.UpdateSprintRequest) error {
+	if sprint.Name == nil && sprint.Goal == nil && sprint.StartDate == nil && sprint.EndDate == nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Nothing to update")
+	}
+	if sprint.Name != nil {
+		name := strings.TrimSpace(*sprint.Name)
+		if name == "" {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+		}
+		sprint.Name = &name
+	}
+	if sprint.Goal != nil {
+		goal := strings.TrimSpace(*sprint.Goal)
+		sprint.Goal = &goal
+	}
+
+	existing, err := s.openSprint(ctx, sprintID)
+	if err != nil {
+		return err
+	}
+	start, end := existing.StartDate, existing.EndDate
+	if sprint.StartDate != nil {
+		start = *sprint.StartDate
+	}
+	if sprint.EndDate != nil {
+		end = *sprint.EndDate
+	}
+	if err := validateDates(start, end); err != nil {
+		return err
+	}
+	return s.sprintRepo.UpdateSprint(ctx, sprintID, sprint)
+}
+
+// DeleteSprint deletes a sprint that has not started, its tasks going back to the backlog
+func (s *service) DeleteSprint(ctx context.Context, sprintID string) error {
+	sprint, err := s.sprintRepo.GetSprintByID(ctx, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.Status != domain.SprintPlanned {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Only planned sprints can be deleted")
+	}
+	return s.sprintRepo.DeleteSprint(ctx, sprintID)
+}
+
+func (s *service) StartSprint(ctx context.Context, sprintID string) (domain.Sprint, error) {
+	if _, err := s.sprintRepo.GetSprintByID(ctx, sprintID); err != nil {
+		return domain.Sprint{}, err
+	}
+	if err := s.sprintRepo.StartSprint(ctx, sprintID); err != nil {
+		return domain.Sprint{}, err
+	}
+	return s.sprintRepo.GetSprintByID(ctx, sprintID)
+}
+
+// CloseSprint closes an active sprint. Its completed tasks stay in it and the unfinished
+// ones are carried over to the requested sprint, or go back to the backlog.
+func (s *service) CloseSprint(ctx context.Context, sprintID string, request domain.CloseSprintRequest, userID string) (domain.CloseSprintResult, error) {
+	sprint, err := s.sprintRepo.GetSprintByID(ctx, sprintID)
+	if err != nil {
+		return domain.CloseSprintResult{}, err
+	}
+	if sprint.Status != domain.SprintActive {
+		return domain.CloseSprintResult{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Only active sprints can be closed")
+	}
+	if request.CarryOverSprintID != nil && *request.CarryOverSprintID == "" {
+		request.CarryOverSprintID = nil
+	}
+	if request.CarryOverSprintID != nil {
+		if *request.CarryOverSprintID == sprintID {
+			return domain.CloseSprintResult{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Unfinished tasks cannot be carried over to the sprint being closed")
+		}
+		if _, err := s.openSprint(ctx, *request.CarryOverSprintID); err != nil {
+			return domain.CloseSprintResult{}, err
+		}
+	}
+
+	carried, err := s.sprintRepo.CloseSprint(ctx, sprintID, request.CarryOverSprintID, userID)
+	if err != nil {
+		return domain.CloseSprintResult{}, err
+	}
+	if carried == nil {
+		carried = []string{}
+	}
+	closed, err := s.sprintRepo.GetSprintByID(ctx, sprintID)
+	if err != nil {
+		return domain.CloseSprintResult{}, err
+	}
+	return domain.CloseSprintResult{Sprint: closed, CarriedOverTaskIDs: carried}, nil
+}
+
+// AddSprintTasks moves tasks, given by ID or key, to a sprint that is not closed, out of the sprint they were in if any
+func (s *service) AddSprintTasks(ctx context.Context, sprintID string, taskIDs []string, userID string) error {
+	if len(taskIDs) == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Task IDs are required")
+	}
+	if _, err := s.openSprint(ctx, sprintID); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(taskIDs))
+	seen := make(map[string]bool, len(taskIDs))
+	for _, ref := range taskIDs {
+		taskID := ref
+		if domain.IsTaskKey(ref) {
+			id, err := s.taskRepo.GetTaskIDByKey(ctx, ref)
+			if err != nil {
+				return err
+			}
+			taskID = id
+		}
+		if seen[taskID] {
+			continue
+		}
+		if _, err := s.taskRepo.GetTaskByID(ctx, taskID); err != nil {
+			return err
+		}
+		seen[taskID] = true
+		ids = append(ids, taskID)
+	}
+	return s.sprintRepo.AddSprintTasks(ctx, sprintID, ids, userID)
+}
+
+// RemoveSprintTask moves a task of a sprint that is not closed back to the backlog
+func (s *service) RemoveSprintTask(ctx context.Context, sprintID, taskID, userID string) error {
+	if _, err := s.openSprint(ctx, sprintID); err != nil {
+		return err
+	}
+	return s.sprintRepo.RemoveSprintTask(ctx, sprintID, taskID, userID)
+}
+
+// openSprint returns a sprint that is not closed
+func (s *service) openSprint(ctx context.Context, sprintID string) (domain.Sprint, error) {
+	sprint, err := s.sprintRepo.GetSprintByID(ctx, sprintID)
+	if err != nil {
+		return domain.Sprint{}, err
+	}
+	if sprint.Status == domain.SprintClosed {
+		return domain.Sprint{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Closed sprints cannot be changed")
+	}
+	return sprint, nil
+}
+
+func validateDates(start, end string) error {
+	from, err := time.Parse(time.DateOnly, start)
+	if err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Start date must be a YYYY-MM-DD date")
+	}
+	to, err := time.Parse(time.DateOnly, end)
+	if err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "End date must be a YYYY-MM-DD date")
+	}
+	if to.Before(from) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "End date must not be before start date")
+	}
+	if to.After(from.AddDate(0, 0, maxSprintDays-1)) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "A sprint spans at most 366 days")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package tasksvc
+
+import (
+	"context"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/assignment"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+)
+
+// defaultSuggestions is the number of suggestions returned when no limit is given
+const defaultSuggestions = 5
+
+// SuggestAssignees ranks employees for a task by workload and by how well their skills match its labels
+func (s *service) SuggestAssignees(ctx context.Context, taskID string, limit int) ([]domain.AssigneeSuggestion, error) {
+	if taskID == "" {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Task ID is required")
+	}
+	if limit <= 0 {
+		limit = defaultSuggestions
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := s.taskRepo.GetWorkloads(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := assignment.Suggest(workloads, task.Labels)
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// autoAssign sets the assignee of a new task with the configured strategy, among the employees
+// under their WIP limit and neither off today nor on the day the task is due
+func (s *service) autoAssign(ctx context.Context, task *domain.CreateTaskRequest) error {
+	workloads, err := s.taskRepo.GetWorkloads(ctx)
+	if err != nil {
+		return err
+	}
+	if workloads, err = s.availableWorkloads(ctx, s.underWIPLimit(workloads), task.DueDate); err != nil {
+		return err
+	}
+	picked, ok := assignment.Pick(s.assignStrategy, workloads, task.Labels)
+	if !ok {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "No employee to assign the task to")
+	}
+	log.Infof(ctx, "Auto-assigning task %q to %s with the %s strategy", task.Title, picked.Username, s.assignStrategy)
+	task.AssigneeID = &picked.UserID
+	return nil
+}
+
+// underWIPLimit leaves out of workloads the employees with as many tasks In Progress as their WIP limit
+func (s *service) underWIPLimit(workloads []domain.Workload) []domain.Workload {
+	under := make([]domain.Workload, 0, len(workloads))
+	for _, w := range workloads {
+		limit := s.wipLimit
+		if w.WIPLimit != nil {
+			limit = *w.WIPLimit
+		}
+		if limit <= 0 || w.InProgressTasks < limit {
+			under = append(under, w)
+		}
+	}
+	return under
+}
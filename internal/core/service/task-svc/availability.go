@@ -0,0 +1,50 @@
+package tasksvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/assignment"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+)
+
+// checkTimeOff refuses to assign a task due on a day the assignee takes off, in their timezone
+func (s *service) checkTimeOff(ctx context.Context, task domain.Task, assignee domain.User) error {
+	// tasks without a due date have the zero time
+	if task.DueDate.IsZero() {
+		return nil
+	}
+	loc, err := domain.LoadTimezone(assignee.Timezone)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	due := task.DueDate.In(loc).Format(time.DateOnly)
+	timeOff, err := s.availabilityRepo.GetTimeOff(ctx, assignee.ID, due, due)
+	if err != nil {
+		return err
+	}
+	if len(timeOff) == 0 {
+		return nil
+	}
+	log.Infof(ctx, "Task %q is due during the time off %s of %s", task.Title, timeOff[0].ID, assignee.ID)
+	return errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, fmt.Sprintf(
+		"%s is off from %s to %s, when the task is due. Set allow_time_off to go ahead anyway", assignee.Username, timeOff[0].StartDate, timeOff[0].EndDate))
+}
+
+// availableWorkloads leaves out of workloads the employees off today or on the day a task is due,
+// in their timezone
+func (s *service) availableWorkloads(ctx context.Context, workloads []domain.Workload, dueDate time.Time) ([]domain.Workload, error) {
+	days := []time.Time{time.Now()}
+	if !dueDate.IsZero() {
+		days = append(days, dueDate)
+	}
+	off, err := s.availabilityRepo.GetUsersOff(ctx, days)
+	if err != nil {
+		return nil, err
+	}
+	return assignment.Without(workloads, off...), nil
+}
@@ -0,0 +1,86 @@
+package tasksvc
+
+import (
+	"context"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+)
+
+// maxCalendarDays bounds the range of a calendar to about a year
+const maxCalendarDays = 366
+
+// GetTaskCalendar groups the tasks due in a range of days by their local day in a timezone,
+// the timezone of the user by default. The range defaults to the current month.
+func (s *service) GetTaskCalendar(ctx context.Context, userRole, userID string, request domain.TaskCalendarRequest) (domain.TaskCalendar, error) {
+	timezone := request.Timezone
+	if timezone == "" {
+		user, err := s.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return domain.TaskCalendar{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "User not found")
+		}
+		timezone = user.Timezone
+	}
+	loc, err := domain.LoadTimezone(timezone)
+	if err != nil {
+		return domain.TaskCalendar{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Timezone must be an IANA timezone such as Europe/Paris")
+	}
+
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 1, -1)
+	if request.From != "" {
+		if from, err = time.ParseInLocation(time.DateOnly, request.From, loc); err != nil {
+			return domain.TaskCalendar{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "From must be a YYYY-MM-DD date")
+		}
+		// without to, the calendar runs to the end of the month of from
+		to = time.Date(from.Year(), from.Month()+1, 0, 0, 0, 0, 0, loc)
+	}
+	if request.To != "" {
+		if to, err = time.ParseInLocation(time.DateOnly, request.To, loc); err != nil {
+			return domain.TaskCalendar{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "To must be a YYYY-MM-DD date")
+		}
+	}
+	if to.Before(from) {
+		return domain.TaskCalendar{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "To must not be before from")
+	}
+	if to.After(from.AddDate(0, 0, maxCalendarDays-1)) {
+		return domain.TaskCalendar{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "A calendar spans at most 366 days")
+	}
+
+	assigneeID := request.AssigneeID
+	if userRole == string(domain.RoleEmployee) {
+		assigneeID = userID
+	}
+	// the end is the local midnight after the last day, which is not always 24 hours after the previous one
+	end := to.AddDate(0, 0, 1)
+	tasks, err := s.taskRepo.GetTasksDueBetween(ctx, from, end, assigneeID)
+	if err != nil {
+		return domain.TaskCalendar{}, err
+	}
+
+	calendar := domain.TaskCalendar{
+		Timezone: loc.String(),
+		From:     from.Format(time.DateOnly),
+		To:       to.Format(time.DateOnly),
+		Total:    len(tasks),
+	}
+	days := make(map[string]int)
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		days[day.Format(time.DateOnly)] = len(calendar.Days)
+		calendar.Days = append(calendar.Days, domain.CalendarDay{Date: day.Format(time.DateOnly)})
+	}
+	for _, task := range tasks {
+		i, ok := days[task.DueDate.In(loc).Format(time.DateOnly)]
+		if !ok {
+			continue
+		}
+		calendar.Days[i].Count++
+		if !request.CountsOnly {
+			calendar.Days[i].Tasks = append(calendar.Days[i].Tasks, task)
+		}
+	}
+	return calendar, nil
+}
@@ -0,0 +1,171 @@
+package tasksvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/taskquery"
+)
+
+// validateCustomFields checks values against the fields defined for the project
+// and returns them in their stored form. Nil values are dropped. Missing
+// required fields are an error.
+func (s *service) validateCustomFields(ctx context.Context, projectID *string, values map[string]any) (map[string]any, error) {
+	definitions, err := s.fieldRepo.GetCustomFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]domain.CustomField, len(definitions))
+	for _, field := range definitions {
+		if field.AppliesTo(projectID) {
+			byKey[field.Key] = field
+		}
+	}
+
+	out := make(map[string]any, len(values))
+	for key, value := range values {
+		if value == nil {
+			continue
+		}
+		field, ok := byKey[key]
+		if !ok {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Custom field %q is not defined for this task", key))
+		}
+		normalized, err := s.customFieldValue(ctx, field, value)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = normalized
+	}
+	for key, field := range byKey {
+		if _, ok := out[key]; field.Required && !ok {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Custom field %q is required", key))
+		}
+	}
+	return out, nil
+}
+
+func (s *service) customFieldValue(ctx context.Context, field domain.CustomField, value any) (any, error) {
+	invalid := errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Invalid value for %s custom field %q", field.Type, field.Key))
+	switch field.Type {
+	case domain.CustomFieldNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return nil, invalid
+		}
+		return number, nil
+	case domain.CustomFieldMultiEnum:
+		items, ok := value.([]any)
+		if !ok {
+			return nil, invalid
+		}
+		selected := make([]string, 0, len(items))
+		seen := make(map[string]bool, len(items))
+		for _, item := range items {
+			option, ok := item.(string)
+			if !ok || !containsOption(field.Options, option) {
+				return nil, invalid
+			}
+			if !seen[option] {
+				seen[option] = true
+				selected = append(selected, option)
+			}
+		}
+		return selected, nil
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return nil, invalid
+	}
+	switch field.Type {
+	case domain.CustomFieldDate:
+		if _, err := time.Parse(time.DateOnly, text); err != nil {
+			return nil, invalid
+		}
+	case domain.CustomFieldEnum:
+		if !containsOption(field.Options, text) {
+			return nil, invalid
+		}
+	case domain.CustomFieldUser:
+		if _, err := s.userRepo.GetUserByID(ctx, text); err != nil {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("User of custom field %q not found", field.Key))
+		}
+	}
+	return text, nil
+}
+
+// resolveCustomTerms types the custom field terms and sort of a task query from the field definitions
+func (s *service) resolveCustomTerms(ctx context.Context, q *taskquery.Query, sort string) error {
+	terms := q.CustomTerms()
+	sortKey, sortCustom := strings.CutPrefix(sort, taskquery.CustomFieldPrefix)
+	if len(terms) == 0 && !sortCustom {
+		return nil
+	}
+
+	definitions, err := s.fieldRepo.GetCustomFields(ctx)
+	if err != nil {
+		return err
+	}
+	byKey := make(map[string]domain.CustomField, len(definitions))
+	for _, field := range definitions {
+		byKey[field.Key] = field
+	}
+
+	if _, ok := byKey[sortKey]; sortCustom && !ok {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Unknown custom field %q", sortKey))
+	}
+	for _, term := range terms {
+		field, ok := byKey[term.CustomKey]
+		if !ok {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Unknown custom field %q", term.CustomKey))
+		}
+		if err := checkCustomTerm(field, term); err != nil {
+			return err
+		}
+		term.CustomType = field.Type
+	}
+	return nil
+}
+
+func checkCustomTerm(field domain.CustomField, term *taskquery.Term) error {
+	switch term.Op {
+	case taskquery.OpMatch, taskquery.OpEqual, taskquery.OpNotEqual:
+	default:
+		if !field.Type.IsOrdered() {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Operator %q is not supported for %s custom field %q", term.Op, field.Type, field.Key))
+		}
+	}
+
+	invalid := errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("Invalid value %q for %s custom field %q", term.Value, field.Type, field.Key))
+	switch field.Type {
+	case domain.CustomFieldNumber:
+		if _, err := strconv.ParseFloat(term.Value, 64); err != nil {
+			return invalid
+		}
+	case domain.CustomFieldDate:
+		if _, err := time.Parse(time.DateOnly, term.Value); err != nil {
+			return invalid
+		}
+	case domain.CustomFieldEnum, domain.CustomFieldMultiEnum:
+		if !containsOption(field.Options, term.Value) {
+			return invalid
+		}
+	}
+	return nil
+}
+
+func containsOption(options []string, value string) bool {
+	for _, option := range options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
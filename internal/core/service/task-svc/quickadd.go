@@ -0,0 +1,84 @@
+package tasksvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/quickadd"
+	"kn-assignment/internal/core/workday"
+	"kn-assignment/internal/log"
+)
+
+// QuickAddTask reads a task from a line of text, its due date in the timezone and on the working
+// days of the user, and creates it when asked to. The task and its assignee are checked as by
+// CreateTask and AssignTask either way, so that what is read back can be created.
+func (s *service) QuickAddTask(ctx context.Context, request domain.QuickAddRequest, userID string) (domain.QuickAddResult, error) {
+	now := time.Now()
+	// the local day of now is at most a day before its UTC one
+	cal, err := s.availabilityRepo.GetWorkCalendar(ctx, userID, now.UTC().AddDate(0, 0, -1).Format(time.DateOnly))
+	if err != nil {
+		return domain.QuickAddResult{}, err
+	}
+	// the time off of the user does not move the due dates of the tasks they create
+	cal.TimeOff = nil
+	calendar, err := workday.New(cal)
+	if err != nil {
+		log.Errorf(ctx, "Invalid work calendar of user %s: %s", userID, err.Error())
+		return domain.QuickAddResult{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	parsed, err := quickadd.Parse(request.Text, now, calendar)
+	if err != nil {
+		log.Infof(ctx, "Invalid quick add %q: %s", request.Text, err.Error())
+		return domain.QuickAddResult{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, err.Error())
+	}
+	task := domain.CreateTaskRequest{
+		Title:    parsed.Title,
+		Labels:   parsed.Labels,
+		Priority: parsed.Priority,
+	}
+	// as with CreateTask, a task without a due date keeps the zero time
+	if parsed.DueDate != nil {
+		task.DueDate = *parsed.DueDate
+	}
+	if err := s.validateNewTask(ctx, &task); err != nil {
+		return domain.QuickAddResult{}, err
+	}
+
+	result := domain.QuickAddResult{
+		Title:    task.Title,
+		DueDate:  parsed.DueDate,
+		Labels:   task.Labels,
+		Priority: task.Priority,
+	}
+	if parsed.Assignee != "" {
+		assignee, err := s.userRepo.GetUserByUsername(ctx, parsed.Assignee)
+		if err != nil {
+			return domain.QuickAddResult{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, fmt.Sprintf("Assignee @%s not found", parsed.Assignee))
+		}
+		if err := checkAssignee(assignee); err != nil {
+			return domain.QuickAddResult{}, err
+		}
+		if !request.AllowTimeOff {
+			if err := s.checkTimeOff(ctx, domain.Task{Title: task.Title, DueDate: task.DueDate}, assignee); err != nil {
+				return domain.QuickAddResult{}, err
+			}
+		}
+		task.AssigneeID = &assignee.ID
+		result.AssigneeID, result.Assignee = &assignee.ID, &assignee.Username
+	}
+	if !request.Create {
+		return result, nil
+	}
+
+	created, err := s.createTasks(ctx, []domain.CreateTaskRequest{task}, userID)
+	if err != nil {
+		return domain.QuickAddResult{}, err
+	}
+	result.Task = &created[0]
+	return result, nil
+}
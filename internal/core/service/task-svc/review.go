@@ -0,0 +1,29 @@
+package tasksvc
+
+import (
+	"context"
+
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/log"
+)
+
+// reviewTarget sends the completion of a task requiring review to In Review.
+// Only the creator of the task, who reviews it, completes it directly.
+func (s *service) reviewTarget(ctx context.Context, task domain.Task, status domain.TaskStatus, userID string) (domain.TaskStatus, error) {
+	if status != domain.StatusCompleted || task.CreatedBy == userID {
+		return status, nil
+	}
+	var project *domain.Project
+	if task.ReviewRequired == nil && task.ProjectID != nil {
+		p, err := s.projectRepo.GetProjectByID(ctx, *task.ProjectID)
+		if err != nil {
+			return "", err
+		}
+		project = &p
+	}
+	if !task.RequiresReview(project) {
+		return status, nil
+	}
+	log.Infof(ctx, "Task %s requires review, moving it to %s", task.ID, domain.StatusInReview)
+	return domain.StatusInReview, nil
+}
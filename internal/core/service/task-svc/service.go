@@ -1,12 +1,45 @@
 package tasksvc
 
-import "kn-assignment/internal/core/port"
+import (
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/port"
+)
 
 type service struct {
-	taskRepo port.TaskRepository
-	userRepo port.UserRepository
+	taskRepo         port.TaskRepository
+	userRepo         port.UserRepository
+	checklistRepo    port.ChecklistRepository
+	projectRepo      port.ProjectRepository
+	fieldRepo        port.CustomFieldRepository
+	slaRepo          port.SLARepository
+	notifyRepo       port.NotificationRepository
+	watcherRepo      port.WatcherRepository
+	linkRepo         port.LinkRepository
+	wipRepo          port.WIPRepository
+	availabilityRepo port.AvailabilityRepository
+	// assignStrategy picks the assignee of tasks created with auto-assignment
+	assignStrategy domain.AssignStrategy
+	// wipLimit is the default number of In Progress tasks of an employee, 0 for no limit
+	wipLimit int
 }
 
-func New(taskRepository port.TaskRepository, userRepo port.UserRepository) port.TaskService {
-	return &service{taskRepo: taskRepository, userRepo: userRepo}
+func New(taskRepository port.TaskRepository, userRepo port.UserRepository, checklistRepo port.ChecklistRepository,
+	projectRepo port.ProjectRepository, fieldRepo port.CustomFieldRepository, slaRepo port.SLARepository, notifyRepo port.NotificationRepository,
+	watcherRepo port.WatcherRepository, linkRepo port.LinkRepository, wipRepo port.WIPRepository, availabilityRepo port.AvailabilityRepository,
+	assignStrategy domain.AssignStrategy, wipLimit int) port.TaskService {
+	return &service{
+		taskRepo:         taskRepository,
+		userRepo:         userRepo,
+		checklistRepo:    checklistRepo,
+		projectRepo:      projectRepo,
+		fieldRepo:        fieldRepo,
+		slaRepo:          slaRepo,
+		notifyRepo:       notifyRepo,
+		watcherRepo:      watcherRepo,
+		linkRepo:         linkRepo,
+		wipRepo:          wipRepo,
+		availabilityRepo: availabilityRepo,
+		assignStrategy:   assignStrategy,
+		wipLimit:         wipLimit,
+	}
 }
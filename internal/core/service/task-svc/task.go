@@ -2,21 +2,160 @@ package tasksvc
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"kn-assignment/internal/constant"
 	"kn-assignment/internal/core/domain"
 	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/markup"
+	"kn-assignment/internal/core/notify"
+	"kn-assignment/internal/core/rank"
+	"kn-assignment/internal/core/taskquery"
 	"kn-assignment/internal/log"
 )
 
 func (s *service) CreateTask(ctx context.Context, task domain.CreateTaskRequest, userId string) error {
+	if err := s.validateNewTask(ctx, &task); err != nil {
+		return err
+	}
+	if task.AutoAssign {
+		if err := s.autoAssign(ctx, &task); err != nil {
+			return err
+		}
+	}
+	_, err := s.createTasks(ctx, []domain.CreateTaskRequest{task}, userId)
+	return err
+}
+
+// CreateTasks validates and creates tasks as CreateTask does, all or none, their assignees
+// being checked as by AssignTask
+func (s *service) CreateTasks(ctx context.Context, tasks []domain.CreateTaskRequest, userID string) ([]domain.Task, error) {
+	for i := range tasks {
+		if err := s.validateNewTask(ctx, &tasks[i]); err != nil {
+			return nil, err
+		}
+		if tasks[i].AssigneeID == nil {
+			continue
+		}
+		assignee, err := s.userRepo.GetUserByID(ctx, *tasks[i].AssigneeID)
+		if err != nil {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Assignee not found")
+		}
+		if err := checkAssignee(assignee); err != nil {
+			return nil, err
+		}
+		if !tasks[i].AllowTimeOff {
+			if err := s.checkTimeOff(ctx, domain.Task{Title: tasks[i].Title, DueDate: tasks[i].DueDate}, assignee); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s.createTasks(ctx, tasks, userID)
+}
+
+// ValidateAssignee checks that tasks can be assigned to a user
+func (s *service) ValidateAssignee(ctx context.Context, assigneeID string) error {
+	assignee, err := s.userRepo.GetUserByID(ctx, assigneeID)
+	if err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Assignee not found")
+	}
+	return checkAssignee(assignee)
+}
+
+// validateNewTask normalizes the fields of a new task and checks them
+func (s *service) validateNewTask(ctx context.Context, task *domain.CreateTaskRequest) error {
 	if task.Title == "" {
 		log.Infof(ctx, "Title is required")
 		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Title is required")
 	}
-	return s.taskRepo.CreateTask(ctx, task, userId)
+	task.Labels = domain.NormalizeLabels(task.Labels)
+	task.Checklist = domain.NormalizeChecklist(task.Checklist)
+	if task.ProjectID != nil {
+		if _, err := s.projectRepo.GetProjectByID(ctx, *task.ProjectID); err != nil {
+			return err
+		}
+	}
+	customFields, err := s.validateCustomFields(ctx, task.ProjectID, task.CustomFields)
+	if err != nil {
+		log.Infof(ctx, "Invalid custom fields: %s", err.Error())
+		return err
+	}
+	task.CustomFields = customFields
+	if task.EstimatedHours != nil && *task.EstimatedHours < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Estimated hours cannot be negative")
+	}
+	if task.StoryPoints != nil && *task.StoryPoints < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Story points cannot be negative")
+	}
+	if err := validateSchedule(task.StartDate, task.DueDate, task.Milestone); err != nil {
+		return err
+	}
+	if task.Priority == "" {
+		task.Priority = domain.PriorityMedium
+	}
+	if !task.Priority.IsValid() {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Priority must be low, medium, high or urgent")
+	}
+	return nil
+}
+
+// createTasks creates validated tasks at the bottom of the Pending column, in order and within
+// its WIP limit, and notifies their assignees and the users they mention
+func (s *service) createTasks(ctx context.Context, tasks []domain.CreateTaskRequest, userId string) ([]domain.Task, error) {
+	lastRank, err := s.taskRepo.GetLastRank(ctx, domain.StatusPending)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tasks {
+		if tasks[i].Rank, err = rank.Between(lastRank, ""); err != nil {
+			log.Errorf(ctx, "Error ranking new task %d: %s", i, err.Error())
+			return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+		}
+		lastRank = tasks[i].Rank
+	}
+
+	var created []domain.Task
+	change := domain.TaskChange{Status: domain.StatusPending, UserID: userId}
+	err = s.checkTaskChange(ctx, change, len(tasks), func(ctx context.Context) error {
+		created, err = s.taskRepo.CreateTasks(ctx, tasks, userId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs := make([]string, 0, len(created))
+	for _, t := range created {
+		taskIDs = append(taskIDs, t.ID)
+	}
+	if err := s.slaRepo.StartTaskSLAs(ctx, taskIDs, []domain.SLAStart{domain.SLAStartCreated, domain.SLAStartAssigned}); err != nil {
+		return nil, err
+	}
+	if err := s.watcherRepo.AutoWatchTasks(ctx, taskIDs); err != nil {
+		return nil, err
+	}
+	var notifications []domain.CreateNotificationRequest
+	for _, t := range created {
+		mentioned, err := s.followRefs(ctx, t.ID, markup.Parse(t.Description), userId)
+		if err != nil {
+			return nil, err
+		}
+		if t.AssigneeID != nil {
+			notifications = append(notifications, notify.Assigned(t.ID, t.Title, *t.AssigneeID, &userId))
+		}
+		notifications = append(notifications, notify.Mentioned(t.ID, fmt.Sprintf("You were mentioned in %q", t.Title), mentioned, userId)...)
+	}
+	notify.Send(ctx, s.notifyRepo, notifications...)
+	return created, nil
 }
 
-func (s *service) AssignTask(ctx context.Context, taskID, assigneeID string) error {
+// AssignTask assigns a task to an employee. Assigning an In Progress task past the WIP
+// limit of the employee takes an override note, and a task due during their time off AllowTimeOff.
+func (s *service) AssignTask(ctx context.Context, taskID string, request domain.AssignTaskRequest, userID string) error {
+	assigneeID := request.AssigneeID
 	if taskID == "" || assigneeID == "" {
 		log.Infof(ctx, "Task ID and Assignee ID are required")
 		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Task ID and Assignee ID are required")
@@ -26,35 +165,144 @@ func (s *service) AssignTask(ctx context.Context, taskID, assigneeID string) err
 	if err != nil {
 		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Assignee not found")
 	}
-	if assignee.Role != domain.RoleEmployee {
-		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Assignee must be an employee")
+	if err := checkAssignee(assignee); err != nil {
+		return err
 	}
-	return s.taskRepo.AssignTask(ctx, taskID, assigneeID)
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	// only employers assign tasks
+	change := domain.TaskChange{Task: task, Status: task.Status, AssigneeID: &assigneeID, OverrideNote: request.OverrideNote,
+		AllowTimeOff: request.AllowTimeOff, UserRole: string(domain.RoleEmployer), UserID: userID}
+	err = s.CheckTaskChange(ctx, change, func(ctx context.Context) error {
+		return s.taskRepo.AssignTask(ctx, taskID, assigneeID)
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.slaRepo.StartTaskSLAs(ctx, []string{taskID}, []domain.SLAStart{domain.SLAStartAssigned}); err != nil {
+		return err
+	}
+	if err := s.watcherRepo.AutoWatchTasks(ctx, []string{taskID}); err != nil {
+		return err
+	}
+	notify.Send(ctx, s.notifyRepo, notify.Assigned(taskID, task.Title, assigneeID, &userID),
+		notify.TaskUpdated(taskID, fmt.Sprintf("%q was assigned to %s", task.Title, assignee.Username), &userID))
+	return nil
 }
 
 func (s *service) GetTasksByAssignee(ctx context.Context, assigneeID string) ([]domain.Task, error) {
 	if assigneeID == "" {
 		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Assignee ID is required")
 	}
-	return s.taskRepo.GetTasksByAssignee(ctx, assigneeID)
+	tasks, err := s.taskRepo.GetTasksByAssignee(ctx, assigneeID)
+	return renderTasks(tasks), err
 }
 
 func (s *service) UpdateTaskStatus(ctx context.Context, taskID string, status domain.TaskStatus, userId string) error {
-	if taskID == "" || status == "" {
-		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Task ID and status are required")
+	if taskID == "" || !status.IsValid() {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Task ID and a valid status are required")
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if status, err = s.reviewTarget(ctx, task, status, userId); err != nil {
+		return err
+	}
+	if err := s.checkCompletion(ctx, taskID, status); err != nil {
+		return err
+	}
+	// assignees cannot override WIP limits
+	change := domain.TaskChange{Task: task, Status: status, AssigneeID: task.AssigneeID, UserID: userId}
+	err = s.CheckTaskChange(ctx, change, func(ctx context.Context) error {
+		if err := s.taskRepo.UpdateTaskStatus(ctx, taskID, status, userId); err != nil {
+			return err
+		}
+		if status == task.Status {
+			return nil
+		}
+		// a task changing column goes to its bottom, as when moved without neighbors
+		return s.taskRepo.RankLast(ctx, taskID, status)
+	})
+	if err != nil {
+		return err
 	}
-	return s.taskRepo.UpdateTaskStatus(ctx, taskID, status, userId)
+	if err := s.slaRepo.UpdateTaskSLAStatus(ctx, taskID, status); err != nil {
+		return err
+	}
+	if status != task.Status {
+		notify.Send(ctx, s.notifyRepo, notify.StatusChanged(task, status, userId))
+	}
+	return nil
 }
 
-func (s *service) GetAllTasks(ctx context.Context, userRole, userID string, filter map[string]string, sort, order string) ([]domain.Task, error) {
+func (s *service) GetAllTasks(ctx context.Context, userRole, userID string, filter map[string]string, query, sort, order string) ([]domain.Task, error) {
+	// the sort field and order go into the ORDER BY clause, so only known ones are let through
+	if sort != "" && !domain.TaskSortFields[sort] && !strings.HasPrefix(sort, taskquery.CustomFieldPrefix) {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Unsupported sort field: "+sort)
+	}
+	if order != "asc" && order != "desc" {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Sort order must be asc or desc")
+	}
+	q, err := taskquery.Parse(query)
+	if err != nil {
+		log.Infof(ctx, "Invalid task query %q: %s", query, err.Error())
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, err.Error())
+	}
+	if err := s.resolveCustomTerms(ctx, q, sort); err != nil {
+		log.Infof(ctx, "Invalid custom field in task query %q: %s", query, err.Error())
+		return nil, err
+	}
+	if q.HasDueDays() {
+		// due dates match the local days of the user
+		user, err := s.userRepo.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "User not found")
+		}
+		if loc, err := domain.LoadTimezone(user.Timezone); err == nil {
+			q.InLocation(loc)
+		}
+	}
 	if userRole == string(domain.RoleEmployee) {
 		filter["assignee_id"] = userID
 	}
-	return s.taskRepo.GetAllTasks(ctx, filter, sort, order)
+	tasks, err := s.taskRepo.GetAllTasks(ctx, filter, q, sort, order)
+	return renderTasks(tasks), err
+}
+
+func (s *service) GetTaskByID(ctx context.Context, taskID string) (domain.Task, error) {
+	if taskID == "" {
+		return domain.Task{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Task ID is required")
+	}
+	taskID, err := s.ResolveTaskID(ctx, taskID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	task.DescriptionHTML = markup.Render(task.Description)
+	return task, err
+}
+
+// ResolveTaskID returns the ID of the task a key such as OPS-142 refers to, or the reference itself when it is not a key
+func (s *service) ResolveTaskID(ctx context.Context, ref string) (string, error) {
+	if !domain.IsTaskKey(ref) {
+		return ref, nil
+	}
+	return s.taskRepo.GetTaskIDByKey(ctx, ref)
 }
 
+// GetTaskSummary summarizes the tasks of each employee, flagging the ones with more tasks In Progress than their WIP limit
 func (s *service) GetTaskSummary(ctx context.Context) ([]domain.TaskSummary, error) {
-	return s.taskRepo.GetTaskSummary(ctx)
+	summaries, err := s.taskRepo.GetTaskSummary(ctx, s.wipLimit)
+	if err != nil {
+		return nil, err
+	}
+	for i, summary := range summaries {
+		summaries[i].OverWIPLimit = summary.WIPLimit != nil && summary.InProgressTasks > *summary.WIPLimit
+	}
+	return summaries, nil
 }
 
 func (s *service) VerifyTaskAssignment(ctx context.Context, taskID, userID string) (bool, error) {
@@ -68,10 +316,281 @@ func (s *service) VerifyTaskAssignment(ctx context.Context, taskID, userID strin
 	return *task.AssigneeID == userID, nil
 }
 
-func (s *service) UpdateTask(ctx context.Context, taskID string, name, description *string) error {
-	return s.taskRepo.UpdateTask(ctx, taskID, name, description)
+func (s *service) UpdateTask(ctx context.Context, taskID string, task domain.UpdateTaskRequest, userID string) error {
+	if task.Title == nil && task.Description == nil && task.Labels == nil && task.ProjectID == nil && task.CustomFields == nil &&
+		task.EstimatedHours == nil && task.StoryPoints == nil && task.StartDate == nil && task.Milestone == nil && task.Priority == nil &&
+		task.ReviewRequired == nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Nothing to update")
+	}
+	if task.Title != nil && *task.Title == "" {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Title is required")
+	}
+	if task.EstimatedHours != nil && *task.EstimatedHours < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Estimated hours cannot be negative")
+	}
+	if task.StoryPoints != nil && *task.StoryPoints < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Story points cannot be negative")
+	}
+	if task.Priority != nil && !task.Priority.IsValid() {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Priority must be low, medium, high or urgent")
+	}
+	if task.Labels != nil {
+		labels := domain.NormalizeLabels(*task.Labels)
+		task.Labels = &labels
+	}
+	if task.ProjectID != nil || task.CustomFields != nil {
+		customFields, err := s.mergeCustomFields(ctx, taskID, task)
+		if err != nil {
+			return err
+		}
+		task.CustomFields = customFields
+	}
+	existing, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if task.StartDate != nil {
+		milestone := existing.Milestone
+		if task.Milestone != nil {
+			milestone = *task.Milestone
+		}
+		if err := validateSchedule(task.StartDate, existing.DueDate, milestone); err != nil {
+			return err
+		}
+	}
+	if err := s.taskRepo.UpdateTask(ctx, taskID, task); err != nil {
+		return err
+	}
+	updated, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		// the update is done, only its notifications are lost
+		return nil
+	}
+	// only the users and tasks the new description adds are notified and linked
+	mentioned, err := s.followRefs(ctx, taskID, markup.Added(existing.Description, updated.Description), userID)
+	if err != nil {
+		return err
+	}
+	notify.Send(ctx, s.notifyRepo, append(notify.Mentioned(taskID, fmt.Sprintf("You were mentioned in %q", updated.Title), mentioned, userID),
+		notify.TaskUpdated(taskID, fmt.Sprintf("%q was updated", updated.Title), &userID))...)
+	return nil
+}
+
+// followRefs links a task to the tasks its description references and returns the mentioned users who exist
+func (s *service) followRefs(ctx context.Context, taskID string, refs markup.Refs, userID string) ([]domain.User, error) {
+	if len(refs.Tasks) > 0 {
+		if err := s.linkRepo.CreateReferenceLinks(ctx, taskID, refs.Tasks, userID); err != nil {
+			return nil, err
+		}
+	}
+	if len(refs.Mentions) == 0 {
+		return nil, nil
+	}
+	users, err := s.userRepo.GetUsersByUsernames(ctx, refs.Mentions)
+	if err != nil {
+		log.Errorf(ctx, "Error getting mentioned users: %s", err.Error())
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return users, nil
+}
+
+// validateSchedule checks that a task starts by its due date, unless it is a milestone, which has no start date
+func validateSchedule(startDate *time.Time, dueDate time.Time, milestone bool) error {
+	if startDate == nil {
+		return nil
+	}
+	if milestone {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Milestones have no start date")
+	}
+	if startDate.After(dueDate) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Start date must not be after the due date")
+	}
+	return nil
+}
+
+// checkAssignee checks that tasks can be assigned to a user
+func checkAssignee(assignee domain.User) error {
+	if assignee.Role != domain.RoleEmployee {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Assignee must be an employee")
+	}
+	return nil
+}
+
+// renderTasks renders the Markdown descriptions of tasks
+func renderTasks(tasks []domain.Task) []domain.Task {
+	for i := range tasks {
+		tasks[i].DescriptionHTML = markup.Render(tasks[i].Description)
+	}
+	return tasks
+}
+
+// mergeCustomFields applies the custom field changes of an update to the values
+// of the task and validates them against the fields of its (new) project
+func (s *service) mergeCustomFields(ctx context.Context, taskID string, update domain.UpdateTaskRequest) (map[string]any, error) {
+	existing, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	projectID := existing.ProjectID
+	if update.ProjectID != nil {
+		projectID = nil
+		if *update.ProjectID != "" {
+			if _, err := s.projectRepo.GetProjectByID(ctx, *update.ProjectID); err != nil {
+				return nil, err
+			}
+			projectID = update.ProjectID
+		}
+	}
+
+	// a task moving to another project drops the values of the fields the new project does not define
+	var defined map[string]bool
+	if moved := update.ProjectID != nil && !sameProject(existing.ProjectID, projectID); moved {
+		definitions, err := s.fieldRepo.GetCustomFields(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defined = make(map[string]bool, len(definitions))
+		for _, field := range definitions {
+			defined[field.Key] = field.AppliesTo(projectID)
+		}
+	}
+	merged := make(map[string]any, len(existing.CustomFields)+len(update.CustomFields))
+	for key, value := range existing.CustomFields {
+		if defined != nil && !defined[key] {
+			log.Infof(ctx, "Task %s drops its value of custom field %q on moving to another project", taskID, key)
+			continue
+		}
+		merged[key] = value
+	}
+	for key, value := range update.CustomFields {
+		merged[key] = value
+	}
+	customFields, err := s.validateCustomFields(ctx, projectID, merged)
+	if err != nil {
+		log.Infof(ctx, "Invalid custom fields: %s", err.Error())
+		return nil, err
+	}
+	return customFields, nil
+}
+
+func sameProject(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
 
 func (s *service) DeleteTask(ctx context.Context, taskID string) error {
 	return s.taskRepo.DeleteTask(ctx, taskID)
 }
+
+func (s *service) MoveTask(ctx context.Context, taskID string, move domain.MoveTaskRequest, userRole, userID string) error {
+	if taskID == "" || !move.Status.IsValid() {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Task ID and a valid status are required")
+	}
+	if taskID == move.PreviousTaskID || taskID == move.NextTaskID {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "A task cannot be its own neighbor")
+	}
+
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if userRole != string(domain.RoleEmployer) && (task.AssigneeID == nil || *task.AssigneeID != userID) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only move tasks assigned to you")
+	}
+	if status, err := s.reviewTarget(ctx, task, move.Status, userID); err != nil {
+		return err
+	} else if status != move.Status {
+		// the neighbors are in the Completed column: append to the review column instead
+		move = domain.MoveTaskRequest{Status: status, OverrideNote: move.OverrideNote}
+	}
+	if err := s.checkCompletion(ctx, taskID, move.Status); err != nil {
+		return err
+	}
+	newRank, err := s.rankBetween(ctx, move)
+	if isInvalidRange(err) {
+		// neighbors share a rank, e.g. after concurrent moves: spread the column and retry once
+		if err := s.taskRepo.RebalanceRanks(ctx, move.Status); err != nil {
+			return err
+		}
+		newRank, err = s.rankBetween(ctx, move)
+	}
+	if isInvalidRange(err) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Previous task must come before next task")
+	}
+	if err != nil {
+		return err
+	}
+
+	change := domain.TaskChange{Task: task, Status: move.Status, AssigneeID: task.AssigneeID, OverrideNote: move.OverrideNote,
+		UserRole: userRole, UserID: userID}
+	err = s.CheckTaskChange(ctx, change, func(ctx context.Context) error {
+		return s.taskRepo.MoveTask(ctx, taskID, move.Status, newRank, userID)
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.slaRepo.UpdateTaskSLAStatus(ctx, taskID, move.Status); err != nil {
+		return err
+	}
+	if move.Status != task.Status {
+		notify.Send(ctx, s.notifyRepo, notify.StatusChanged(task, move.Status, userID))
+	}
+	if len(newRank) > rank.MaxLength {
+		return s.taskRepo.RebalanceRanks(ctx, move.Status)
+	}
+	return nil
+}
+
+// checkCompletion refuses to complete a task, or submit it for review, while required checklist items are not done
+func (s *service) checkCompletion(ctx context.Context, taskID string, status domain.TaskStatus) error {
+	if status != domain.StatusCompleted && status != domain.StatusInReview {
+		return nil
+	}
+	open, err := s.checklistRepo.CountOpenRequiredItems(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if open > 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, fmt.Sprintf("%d required checklist items are not done", open))
+	}
+	return nil
+}
+
+// rankBetween returns a rank between the neighbors of a move
+func (s *service) rankBetween(ctx context.Context, move domain.MoveTaskRequest) (string, error) {
+	previousRank, err := s.neighborRank(ctx, move.PreviousTaskID, move.Status)
+	if err != nil {
+		return "", err
+	}
+	nextRank, err := s.neighborRank(ctx, move.NextTaskID, move.Status)
+	if err != nil {
+		return "", err
+	}
+	if move.PreviousTaskID == "" && move.NextTaskID == "" {
+		// no neighbors given: append to the column
+		if previousRank, err = s.taskRepo.GetLastRank(ctx, move.Status); err != nil {
+			return "", err
+		}
+	}
+	return rank.Between(previousRank, nextRank)
+}
+
+func (s *service) neighborRank(ctx context.Context, taskID string, status domain.TaskStatus) (string, error) {
+	if taskID == "" {
+		return "", nil
+	}
+	neighbor, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return "", err
+	}
+	if neighbor.Status != status {
+		return "", errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Neighboring tasks must be in the target status")
+	}
+	return neighbor.Rank, nil
+}
+
+func isInvalidRange(err error) bool {
+	return stderrors.Is(err, rank.ErrInvalidRange)
+}
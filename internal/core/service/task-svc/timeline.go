@@ -0,0 +1,105 @@
+package tasksvc
+
+import (
+	"context"
+	stderrors "errors"
+	"math"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/schedule"
+)
+
+// GetTaskTimeline plans the tasks matching a filter and a search query, as GET /tasks does, along
+// the blocked_by links between them. Blockers outside of the selection do not constrain it.
+func (s *service) GetTaskTimeline(ctx context.Context, userRole, userID string, filter map[string]string, query string) (domain.TaskTimeline, error) {
+	tasks, err := s.GetAllTasks(ctx, userRole, userID, filter, query, "due_date", "asc")
+	if err != nil {
+		return domain.TaskTimeline{}, err
+	}
+	timeline := domain.TaskTimeline{Tasks: []domain.TimelineTask{}, Dependencies: []domain.TimelineDependency{}, CriticalPath: []string{}}
+	planned := make([]domain.Task, 0, len(tasks))
+	taskIDs := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		// tasks without due date cannot be placed
+		if task.DueDate.IsZero() {
+			continue
+		}
+		planned = append(planned, task)
+		taskIDs = append(taskIDs, task.ID)
+	}
+	if len(planned) == 0 {
+		return timeline, nil
+	}
+
+	links, err := s.linkRepo.GetBlockingLinks(ctx, taskIDs)
+	if err != nil {
+		return domain.TaskTimeline{}, err
+	}
+	blockers := make(map[string][]string)
+	for _, link := range links {
+		blockers[link.SourceTaskID] = append(blockers[link.SourceTaskID], link.TargetTaskID)
+		timeline.Dependencies = append(timeline.Dependencies, domain.TimelineDependency{
+			LinkID:        link.ID,
+			TaskID:        link.SourceTaskID,
+			BlockerTaskID: link.TargetTaskID,
+		})
+	}
+
+	items := make([]schedule.Item, 0, len(planned))
+	for _, task := range planned {
+		items = append(items, schedule.Item{
+			ID:       task.ID,
+			Start:    barStart(task),
+			Finish:   task.DueDate,
+			Done:     task.Status == domain.StatusCompleted,
+			Blockers: blockers[task.ID],
+		})
+	}
+	results, path, err := schedule.Plan(items)
+	if stderrors.Is(err, schedule.ErrCycle) {
+		return domain.TaskTimeline{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "The dependencies of the tasks form a cycle")
+	}
+	if err != nil {
+		return domain.TaskTimeline{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	for i, task := range planned {
+		r := results[task.ID]
+		timeline.Tasks = append(timeline.Tasks, domain.TimelineTask{
+			ID:             task.ID,
+			Key:            task.Key,
+			Title:          task.Title,
+			Status:         task.Status,
+			AssigneeID:     task.AssigneeID,
+			Milestone:      task.Milestone,
+			Start:          items[i].Start,
+			Due:            task.DueDate,
+			EarliestStart:  r.EarliestStart,
+			EarliestFinish: r.EarliestFinish,
+			LatestStart:    r.LatestStart,
+			LatestFinish:   r.LatestFinish,
+			SlackHours:     math.Round(r.Slack.Hours()*100) / 100,
+			Critical:       r.Critical,
+			AtRisk:         task.Status != domain.StatusCompleted && task.DueDate.Before(r.EarliestFinish),
+		})
+	}
+	timeline.CriticalPath = path
+	return timeline, nil
+}
+
+// barStart returns the planned start of a task: its start date, else its estimated hours before its due date
+func barStart(task domain.Task) time.Time {
+	switch {
+	case task.Milestone:
+		return task.DueDate
+	case task.StartDate != nil:
+		return *task.StartDate
+	case task.EstimatedHours != nil:
+		return task.DueDate.Add(-time.Duration(*task.EstimatedHours * float64(time.Hour)))
+	default:
+		return task.DueDate
+	}
+}
@@ -0,0 +1,161 @@
+package tasksvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+)
+
+// wipLimit is a WIP limit a task change is checked against: the limit of a status column,
+// or of an employee when employee is set
+type wipLimit struct {
+	scope    domain.WIPLimitScope
+	limit    int
+	employee *domain.User
+}
+
+// CheckTaskChange checks a task change against the time off of a new assignee and the WIP limits
+// it is subject to, then applies it. The limits stay locked from counting their tasks until the
+// change is applied, so that concurrent changes cannot both pass them, and the limits an employer
+// overrides are recorded with the change. Every change of the status or of the assignee of a task
+// goes through it.
+func (s *service) CheckTaskChange(ctx context.Context, change domain.TaskChange, apply func(ctx context.Context) error) error {
+	return s.checkTaskChange(ctx, change, 1, apply)
+}
+
+// checkTaskChange checks a change bringing n tasks into a status, n being more than one for tasks
+// created together
+func (s *service) checkTaskChange(ctx context.Context, change domain.TaskChange, n int, apply func(ctx context.Context) error) error {
+	task := change.Task
+	reassigned := change.AssigneeID != nil && (task.AssigneeID == nil || *task.AssigneeID != *change.AssigneeID)
+	if reassigned && !change.AllowTimeOff {
+		assignee, err := s.userRepo.GetUserByID(ctx, *change.AssigneeID)
+		if err != nil {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Assignee not found")
+		}
+		if err := s.checkTimeOff(ctx, task, assignee); err != nil {
+			return err
+		}
+	}
+
+	limits, err := s.wipLimits(ctx, change)
+	if err != nil {
+		return err
+	}
+	if len(limits) == 0 {
+		return apply(ctx)
+	}
+
+	var statuses []domain.TaskStatus
+	var employeeIDs []string
+	for _, l := range limits {
+		if l.employee != nil {
+			employeeIDs = append(employeeIDs, l.employee.ID)
+		} else {
+			statuses = append(statuses, change.Status)
+		}
+	}
+	return s.wipRepo.LockWIP(ctx, statuses, employeeIDs, func(ctx context.Context) error {
+		overrides, err := s.checkWIPLimits(ctx, change, n, limits)
+		if err != nil {
+			return err
+		}
+		if err := apply(ctx); err != nil {
+			return err
+		}
+		return s.recordWIPOverrides(ctx, overrides)
+	})
+}
+
+// wipLimits returns the WIP limits a task change is subject to: the limit of the status column
+// the task enters, and the limit of the assignee when it becomes one of their In Progress tasks
+func (s *service) wipLimits(ctx context.Context, change domain.TaskChange) ([]wipLimit, error) {
+	task := change.Task
+	var limits []wipLimit
+	if change.Status != task.Status {
+		limit, err := s.wipRepo.GetStatusWIPLimit(ctx, change.Status)
+		if err != nil {
+			return nil, err
+		}
+		if limit != nil {
+			limits = append(limits, wipLimit{scope: domain.WIPScopeStatus, limit: *limit})
+		}
+	}
+
+	assigneeID := change.AssigneeID
+	joins := task.Status != change.Status || task.AssigneeID == nil || (assigneeID != nil && *task.AssigneeID != *assigneeID)
+	if change.Status == domain.StatusInProgress && assigneeID != nil && joins {
+		assignee, err := s.userRepo.GetUserByID(ctx, *assigneeID)
+		if err != nil {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Assignee not found")
+		}
+		limit := s.wipLimit
+		if assignee.WIPLimit != nil {
+			limit = *assignee.WIPLimit
+		}
+		if limit > 0 {
+			limits = append(limits, wipLimit{scope: domain.WIPScopeEmployee, limit: limit, employee: &assignee})
+		}
+	}
+	return limits, nil
+}
+
+// checkWIPLimits returns the WIP limits a change bringing n tasks goes past. Going past a limit
+// is a conflict unless an employer gives a note, the overrides being returned to be recorded
+// once the change is applied.
+func (s *service) checkWIPLimits(ctx context.Context, change domain.TaskChange, n int, limits []wipLimit) ([]domain.WIPOverride, error) {
+	var exceeded []domain.WIPOverride
+	var reasons []string
+	for _, l := range limits {
+		var employeeID *string
+		if l.employee != nil {
+			employeeID = &l.employee.ID
+		}
+		count, err := s.wipRepo.CountStatusTasks(ctx, change.Status, employeeID, change.Task.ID)
+		if err != nil {
+			return nil, err
+		}
+		if count+n <= l.limit {
+			continue
+		}
+		exceeded = append(exceeded, domain.WIPOverride{Scope: l.scope, EmployeeID: employeeID, WIPLimit: l.limit, TaskCount: count})
+		if l.employee != nil {
+			reasons = append(reasons, fmt.Sprintf("%s has %d tasks In Progress for a limit of %d", l.employee.Username, count, l.limit))
+		} else {
+			reasons = append(reasons, fmt.Sprintf("the %s column holds %d tasks for a limit of %d", change.Status, count, l.limit))
+		}
+	}
+	if len(exceeded) == 0 {
+		return nil, nil
+	}
+
+	note := strings.TrimSpace(change.OverrideNote)
+	if note == "" {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict,
+			fmt.Sprintf("WIP limit reached: %s. An employer can override it with a note", strings.Join(reasons, " and ")))
+	}
+	if change.UserRole != string(domain.RoleEmployer) {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "Only employers can override WIP limits")
+	}
+	for i := range exceeded {
+		exceeded[i].TaskID = change.Task.ID
+		exceeded[i].Status = change.Status
+		exceeded[i].Note = note
+		exceeded[i].OverriddenBy = change.UserID
+	}
+	return exceeded, nil
+}
+
+// recordWIPOverrides keeps the WIP limits a task went past for audit
+func (s *service) recordWIPOverrides(ctx context.Context, overrides []domain.WIPOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	log.Infof(ctx, "Task %s overrides %d WIP limits", overrides[0].TaskID, len(overrides))
+	return s.wipRepo.CreateWIPOverrides(ctx, overrides)
+}
@@ -0,0 +1,48 @@
+package templatesvc
+
+import (
+	"context"
+	"time"
+
+	"kn-assignment/internal/constant"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/workday"
+	"kn-assignment/internal/log"
+)
+
+// calendarKey tells apart the calendar of a user as an assignee, with their time off, from the
+// one as the creator of unassigned tasks
+type calendarKey struct {
+	userID   string
+	assigned bool
+}
+
+// calendars caches the work calendars of the users tasks are created for in one request
+type calendars map[calendarKey]workday.Calendar
+
+// businessDueDate returns the end of the working day n business days of a user ahead. The
+// calendar of the assignee counts their time off, the one of the creator of unassigned tasks not.
+func (s *service) businessDueDate(ctx context.Context, cache calendars, userID string, assigned bool, from time.Time, n int) (time.Time, error) {
+	key := calendarKey{userID: userID, assigned: assigned}
+	calendar, ok := cache[key]
+	if !ok {
+		// the local day of from is at most a day before its UTC one
+		cal, err := s.availabilityRepo.GetWorkCalendar(ctx, userID, from.UTC().AddDate(0, 0, -1).Format(time.DateOnly))
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !assigned {
+			cal.TimeOff = nil
+		}
+		if calendar, err = workday.New(cal); err != nil {
+			log.Errorf(ctx, "Invalid work calendar of user %s: %s", userID, err.Error())
+			return time.Time{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+		}
+		cache[key] = calendar
+	}
+	due, err := calendar.AddBusinessDays(from, n)
+	if err != nil {
+		return time.Time{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "No working day to set the due date on")
+	}
+	return due, nil
+}
@@ -0,0 +1,43 @@
+package templatesvc
+
+import (
+	"regexp"
+	"strings"
+)
+
+// placeholderPattern matches {{name}}, allowing spaces inside the braces
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// placeholders returns the variable names used in texts, in order of first use
+func placeholders(texts ...string) []string {
+	names := []string{}
+	seen := map[string]bool{}
+	for _, text := range texts {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				names = append(names, match[1])
+			}
+		}
+	}
+	return names
+}
+
+// render replaces the placeholders of text with their values
+func render(text string, variables map[string]string) string {
+	return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := placeholderPattern.FindStringSubmatch(placeholder)[1]
+		return variables[name]
+	})
+}
+
+// missingVariables returns the names without a non-blank value
+func missingVariables(names []string, variables map[string]string) []string {
+	var missing []string
+	for _, name := range names {
+		if strings.TrimSpace(variables[name]) == "" {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,14 @@
+package templatesvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	templateRepo     port.TaskTemplateRepository
+	availabilityRepo port.AvailabilityRepository
+	// taskService validates and creates the tasks of templates as any new task
+	taskService port.TaskService
+}
+
+func New(templateRepo port.TaskTemplateRepository, availabilityRepo port.AvailabilityRepository, taskService port.TaskService) port.TaskTemplateService {
+	return &service{templateRepo: templateRepo, availabilityRepo: availabilityRepo, taskService: taskService}
+}
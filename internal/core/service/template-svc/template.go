@@ -0,0 +1,200 @@
+package templatesvc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+)
+
+// maxInstances caps the number of tasks created from a template in one request
+const maxInstances = 100
+
+func (s *service) CreateTaskTemplate(ctx context.Context, template domain.CreateTaskTemplateRequest, userID string) (domain.TaskTemplate, error) {
+	template.Labels = domain.NormalizeLabels(template.Labels)
+	template.Checklist = domain.NormalizeChecklist(template.Checklist)
+	if err := s.validateTemplate(ctx, template); err != nil {
+		log.Infof(ctx, "Invalid task template: %s", err.Error())
+		return domain.TaskTemplate{}, err
+	}
+	created, err := s.templateRepo.CreateTaskTemplate(ctx, template, userID)
+	if err != nil {
+		return domain.TaskTemplate{}, err
+	}
+	return withVariables(created), nil
+}
+
+func (s *service) GetTaskTemplate(ctx context.Context, templateID string) (domain.TaskTemplate, error) {
+	if templateID == "" {
+		return domain.TaskTemplate{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Template ID is required")
+	}
+	template, err := s.templateRepo.GetTaskTemplateByID(ctx, templateID)
+	if err != nil {
+		return domain.TaskTemplate{}, err
+	}
+	return withVariables(template), nil
+}
+
+func (s *service) GetTaskTemplates(ctx context.Context) ([]domain.TaskTemplate, error) {
+	templates, err := s.templateRepo.GetTaskTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range templates {
+		templates[i] = withVariables(templates[i])
+	}
+	return templates, nil
+}
+
+func (s *service) UpdateTaskTemplate(ctx context.Context, templateID string, template domain.UpdateTaskTemplateRequest) error {
+	existing, err := s.GetTaskTemplate(ctx, templateID)
+	if err != nil {
+		return err
+	}
+
+	merged := domain.CreateTaskTemplateRequest{
+		Name:                  existing.Name,
+		Title:                 existing.Title,
+		Description:           existing.Description,
+		DueOffsetHours:        existing.DueOffsetHours,
+		DueOffsetBusinessDays: existing.DueOffsetBusinessDays,
+		AssigneeID:            existing.AssigneeID,
+		Labels:                existing.Labels,
+		Checklist:             existing.Checklist,
+	}
+	if template.Name != nil {
+		merged.Name = *template.Name
+	}
+	if template.Title != nil {
+		merged.Title = *template.Title
+	}
+	if template.Description != nil {
+		merged.Description = *template.Description
+	}
+	switch {
+	case template.DueOffsetHours != nil && template.DueOffsetBusinessDays != nil:
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Set either due_offset_hours or due_offset_business_days")
+	case template.DueOffsetHours != nil:
+		merged.DueOffsetHours, merged.DueOffsetBusinessDays = template.DueOffsetHours, nil
+	case template.DueOffsetBusinessDays != nil:
+		merged.DueOffsetHours, merged.DueOffsetBusinessDays = nil, template.DueOffsetBusinessDays
+	}
+	if template.AssigneeID != nil {
+		merged.AssigneeID = template.AssigneeID
+	}
+	if template.Labels != nil {
+		labels := domain.NormalizeLabels(*template.Labels)
+		template.Labels, merged.Labels = &labels, labels
+	}
+	if template.Checklist != nil {
+		checklist := domain.NormalizeChecklist(*template.Checklist)
+		template.Checklist, merged.Checklist = &checklist, checklist
+	}
+	if err := s.validateTemplate(ctx, merged); err != nil {
+		log.Infof(ctx, "Invalid task template: %s", err.Error())
+		return err
+	}
+	return s.templateRepo.UpdateTaskTemplate(ctx, templateID, template)
+}
+
+func (s *service) DeleteTaskTemplate(ctx context.Context, templateID string) error {
+	if _, err := s.GetTaskTemplate(ctx, templateID); err != nil {
+		return err
+	}
+	return s.templateRepo.DeleteTaskTemplate(ctx, templateID)
+}
+
+// CreateTasksFromTemplate creates one task per instance, all or none, through the task service
+// as any new task. With no instances a single task is created from the template defaults.
+func (s *service) CreateTasksFromTemplate(ctx context.Context, templateID string, instances []domain.TemplateInstance, userID string) ([]domain.Task, error) {
+	template, err := s.GetTaskTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) == 0 {
+		instances = []domain.TemplateInstance{{}}
+	}
+	if len(instances) > maxInstances {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Too many tasks, the maximum is 100")
+	}
+
+	now := time.Now()
+	cache := calendars{}
+	tasks := make([]domain.CreateTaskRequest, 0, len(instances))
+	for _, instance := range instances {
+		if missing := missingVariables(template.Variables, instance.Variables); len(missing) > 0 {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Missing template variables: "+strings.Join(missing, ", "))
+		}
+
+		task := domain.CreateTaskRequest{
+			Title:        render(template.Title, instance.Variables),
+			Description:  render(template.Description, instance.Variables),
+			Labels:       template.Labels,
+			Checklist:    renderChecklist(template.Checklist, instance.Variables),
+			AssigneeID:   template.AssigneeID,
+			AllowTimeOff: instance.AllowTimeOff,
+		}
+		if instance.AssigneeID != nil {
+			task.AssigneeID = instance.AssigneeID
+		}
+		switch {
+		case instance.DueDate != nil:
+			task.DueDate = *instance.DueDate
+		case template.DueOffsetHours != nil:
+			task.DueDate = now.Add(time.Duration(*template.DueOffsetHours) * time.Hour)
+		case template.DueOffsetBusinessDays != nil:
+			owner := userID
+			if task.AssigneeID != nil {
+				owner = *task.AssigneeID
+			}
+			if task.DueDate, err = s.businessDueDate(ctx, cache, owner, task.AssigneeID != nil, now, *template.DueOffsetBusinessDays); err != nil {
+				return nil, err
+			}
+		}
+		if strings.TrimSpace(task.Title) == "" {
+			return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Title is required")
+		}
+		tasks = append(tasks, task)
+	}
+	return s.taskService.CreateTasks(ctx, tasks, userID)
+}
+
+func renderChecklist(items []string, variables map[string]string) []string {
+	rendered := make([]string, 0, len(items))
+	for _, item := range items {
+		rendered = append(rendered, render(item, variables))
+	}
+	return rendered
+}
+
+func (s *service) validateTemplate(ctx context.Context, template domain.CreateTaskTemplateRequest) error {
+	if strings.TrimSpace(template.Name) == "" {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+	}
+	if strings.TrimSpace(template.Title) == "" {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Title is required")
+	}
+	if template.DueOffsetHours != nil && *template.DueOffsetHours < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Due offset cannot be negative")
+	}
+	if template.DueOffsetBusinessDays != nil && *template.DueOffsetBusinessDays < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Due offset cannot be negative")
+	}
+	if template.DueOffsetHours != nil && template.DueOffsetBusinessDays != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Set either due_offset_hours or due_offset_business_days")
+	}
+	if template.AssigneeID != nil {
+		return s.taskService.ValidateAssignee(ctx, *template.AssigneeID)
+	}
+	return nil
+}
+
+func withVariables(template domain.TaskTemplate) domain.TaskTemplate {
+	texts := append([]string{template.Title, template.Description}, template.Checklist...)
+	template.Variables = placeholders(texts...)
+	return template
+}
@@ -0,0 +1,11 @@
+package usersvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	userRepo port.UserRepository
+}
+
+func New(userRepo port.UserRepository) port.UserService {
+	return &service{userRepo: userRepo}
+}
@@ -0,0 +1,127 @@
+package usersvc
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+func (s *service) GetUserByID(ctx context.Context, userID string) (domain.User, error) {
+	if userID == "" {
+		return domain.User{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "User ID is required")
+	}
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Errorf(ctx, "Error getting user by id: %s", err.Error())
+		return domain.User{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "User not found")
+	}
+	return user, nil
+}
+
+func (s *service) GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	if len(userIDs) == 0 {
+		return []domain.User{}, nil
+	}
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		log.Errorf(ctx, "Error getting users by ids: %s", err.Error())
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return users, nil
+}
+
+// UpdateUserSkills replaces the skills of an employee, which are matched against task labels
+func (s *service) UpdateUserSkills(ctx context.Context, userID string, skills []string) error {
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role != domain.RoleEmployee {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Only employees have skills")
+	}
+	if err := s.userRepo.UpdateUserSkills(ctx, userID, domain.NormalizeLabels(skills)); err != nil {
+		log.Errorf(ctx, "Error updating user skills: %s", err.Error())
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// UpdateUserClaimLimit sets how many pool tasks an employee can hold, nil for the default limit
+func (s *service) UpdateUserClaimLimit(ctx context.Context, userID string, limit *int) error {
+	if limit != nil && *limit < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Claim limit must not be negative")
+	}
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role != domain.RoleEmployee {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Only employees claim tasks")
+	}
+	if err := s.userRepo.UpdateUserClaimLimit(ctx, userID, limit); err != nil {
+		log.Errorf(ctx, "Error updating user claim limit: %s", err.Error())
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// UpdateUserWIPLimit sets how many tasks an employee can have In Progress, nil for the default limit
+func (s *service) UpdateUserWIPLimit(ctx context.Context, userID string, limit *int) error {
+	if limit != nil && *limit < 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "WIP limit must not be negative")
+	}
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role != domain.RoleEmployee {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Only employees work on tasks")
+	}
+	if err := s.userRepo.UpdateUserWIPLimit(ctx, userID, limit); err != nil {
+		log.Errorf(ctx, "Error updating user WIP limit: %s", err.Error())
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// UpdateUserTimezone sets the IANA timezone calendars and due date searches are read in for a user
+func (s *service) UpdateUserTimezone(ctx context.Context, userID, timezone string) error {
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Timezone must be an IANA timezone such as Europe/Paris")
+	}
+	if _, err := s.GetUserByID(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdateUserTimezone(ctx, userID, timezone); err != nil {
+		log.Errorf(ctx, "Error updating user timezone: %s", err.Error())
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// UpdateUserEmail sets the address notifications and digests are sent to, nil or empty to stop emails
+func (s *service) UpdateUserEmail(ctx context.Context, userID string, email *string) error {
+	if email != nil {
+		address := strings.TrimSpace(*email)
+		if address == "" {
+			email = nil
+		} else if parsed, err := mail.ParseAddress(address); err != nil || parsed.Address != address {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Email must be an address such as jane@example.com")
+		} else {
+			email = &address
+		}
+	}
+	if _, err := s.GetUserByID(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdateUserEmail(ctx, userID, email); err != nil {
+		log.Errorf(ctx, "Error updating user email: %s", err.Error())
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package viewsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	viewRepo port.TaskViewRepository
+}
+
+func New(viewRepo port.TaskViewRepository) port.TaskViewService {
+	return &service{viewRepo: viewRepo}
+}
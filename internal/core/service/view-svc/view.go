@@ -0,0 +1,187 @@
+package viewsvc
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/taskquery"
+	"kn-assignment/internal/log"
+	"strings"
+)
+
+// filterFields are the task columns a view can filter on, as with GET /tasks
+var filterFields = map[string]bool{
+	"assignee_id": true,
+	"status":      true,
+	"priority":    true,
+	"project_id":  true,
+	"sprint_id":   true,
+}
+
+var columns = map[string]bool{
+	"id":            true,
+	"title":         true,
+	"description":   true,
+	"assignee_id":   true,
+	"status":        true,
+	"labels":        true,
+	"priority":      true,
+	"rank":          true,
+	"project_id":    true,
+	"sprint_id":     true,
+	"story_points":  true,
+	"custom_fields": true,
+	"created_at":    true,
+	"created_by":    true,
+	"updated_at":    true,
+	"updated_by":    true,
+	"due_date":      true,
+}
+
+func (s *service) CreateTaskView(ctx context.Context, view domain.CreateTaskViewRequest, userRole, userID string) (domain.TaskView, error) {
+	if view.Visibility == "" {
+		view.Visibility = domain.VisibilityPrivate
+	}
+	if view.SortOrder == "" {
+		view.SortOrder = "asc"
+	}
+	if view.Filters == nil {
+		view.Filters = map[string]string{}
+	}
+	if view.Columns == nil {
+		view.Columns = []string{}
+	}
+	if err := validateView(view, userRole); err != nil {
+		log.Infof(ctx, "Invalid task view: %s", err.Error())
+		return domain.TaskView{}, err
+	}
+	return s.viewRepo.CreateTaskView(ctx, view, userID)
+}
+
+func (s *service) GetTaskView(ctx context.Context, viewID, userID string) (domain.TaskView, error) {
+	if viewID == "" {
+		return domain.TaskView{}, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "View ID is required")
+	}
+	view, err := s.viewRepo.GetTaskViewByID(ctx, viewID)
+	if err != nil {
+		return domain.TaskView{}, err
+	}
+	if view.OwnerID != userID && view.Visibility != domain.VisibilityShared {
+		return domain.TaskView{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "View not found")
+	}
+	return view, nil
+}
+
+func (s *service) GetTaskViews(ctx context.Context, userID string) ([]domain.TaskView, error) {
+	return s.viewRepo.GetTaskViewsForUser(ctx, userID)
+}
+
+// GetDefaultTaskView returns the view employers published for the task list of employees, if any
+func (s *service) GetDefaultTaskView(ctx context.Context) (*domain.TaskView, error) {
+	return s.viewRepo.GetDefaultTaskView(ctx)
+}
+
+func (s *service) UpdateTaskView(ctx context.Context, viewID string, view domain.UpdateTaskViewRequest, userRole, userID string) error {
+	existing, err := s.getOwnedView(ctx, viewID, userID)
+	if err != nil {
+		return err
+	}
+
+	merged := domain.CreateTaskViewRequest{
+		Name:       existing.Name,
+		Visibility: existing.Visibility,
+		IsDefault:  existing.IsDefault,
+		Filters:    existing.Filters,
+		Query:      existing.Query,
+		Sort:       existing.Sort,
+		SortOrder:  existing.SortOrder,
+		Columns:    existing.Columns,
+	}
+	if view.Name != nil {
+		merged.Name = *view.Name
+	}
+	if view.Visibility != nil {
+		merged.Visibility = *view.Visibility
+	}
+	if view.IsDefault != nil {
+		merged.IsDefault = *view.IsDefault
+	}
+	if view.Filters != nil {
+		merged.Filters = *view.Filters
+	}
+	if view.Query != nil {
+		merged.Query = *view.Query
+	}
+	if view.Sort != nil {
+		merged.Sort = *view.Sort
+	}
+	if view.SortOrder != nil {
+		merged.SortOrder = *view.SortOrder
+	}
+	if view.Columns != nil {
+		merged.Columns = *view.Columns
+	}
+	if err := validateView(merged, userRole); err != nil {
+		log.Infof(ctx, "Invalid task view: %s", err.Error())
+		return err
+	}
+	return s.viewRepo.UpdateTaskView(ctx, viewID, view)
+}
+
+func (s *service) DeleteTaskView(ctx context.Context, viewID, userID string) error {
+	if _, err := s.getOwnedView(ctx, viewID, userID); err != nil {
+		return err
+	}
+	return s.viewRepo.DeleteTaskView(ctx, viewID)
+}
+
+func (s *service) getOwnedView(ctx context.Context, viewID, userID string) (domain.TaskView, error) {
+	view, err := s.GetTaskView(ctx, viewID, userID)
+	if err != nil {
+		return domain.TaskView{}, err
+	}
+	if view.OwnerID != userID {
+		return domain.TaskView{}, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only change your own views")
+	}
+	return view, nil
+}
+
+func validateView(view domain.CreateTaskViewRequest, userRole string) error {
+	if strings.TrimSpace(view.Name) == "" {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Name is required")
+	}
+	if view.Visibility != domain.VisibilityPrivate && view.Visibility != domain.VisibilityShared {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Visibility must be private or shared")
+	}
+	// default views are published by employers for employees, so they have to be shared
+	if view.IsDefault {
+		if userRole != string(domain.RoleEmployer) {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "Only employers can publish default views")
+		}
+		if view.Visibility != domain.VisibilityShared {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Default views must be shared")
+		}
+	}
+	for field := range view.Filters {
+		if !filterFields[field] {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Unsupported filter: "+field)
+		}
+	}
+	if _, err := taskquery.Parse(view.Query); err != nil {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, err.Error())
+	}
+	// custom fields are checked when the view runs, as they may be deleted meanwhile
+	if view.Sort != "" && !domain.TaskSortFields[view.Sort] && !strings.HasPrefix(view.Sort, taskquery.CustomFieldPrefix) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Unsupported sort field: "+view.Sort)
+	}
+	if view.SortOrder != "asc" && view.SortOrder != "desc" {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Sort order must be asc or desc")
+	}
+	for _, column := range view.Columns {
+		if !columns[column] && !strings.HasPrefix(column, taskquery.CustomFieldPrefix) {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Unsupported column: "+column)
+		}
+	}
+	return nil
+}
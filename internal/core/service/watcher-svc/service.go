@@ -0,0 +1,12 @@
+package watchersvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	watcherRepo port.WatcherRepository
+	taskRepo    port.TaskRepository
+}
+
+func New(watcherRepo port.WatcherRepository, taskRepo port.TaskRepository) port.WatcherService {
+	return &service{watcherRepo: watcherRepo, taskRepo: taskRepo}
+}
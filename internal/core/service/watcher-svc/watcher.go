@@ -0,0 +1,69 @@
+package watchersvc
+
+import (
+	"context"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+)
+
+// WatchTask lets a user who can read a task follow it, or mute or unmute a task they watch
+func (s *service) WatchTask(ctx context.Context, taskID, userRole, userID string, request domain.WatchTaskRequest) (domain.TaskWatcher, error) {
+	if err := s.checkTask(ctx, taskID, userRole, userID); err != nil {
+		return domain.TaskWatcher{}, err
+	}
+	return s.watcherRepo.WatchTask(ctx, taskID, userID, request.Muted)
+}
+
+func (s *service) UnwatchTask(ctx context.Context, taskID, userID string) error {
+	return s.watcherRepo.UnwatchTask(ctx, taskID, userID)
+}
+
+func (s *service) GetTaskWatchers(ctx context.Context, taskID, userRole, userID string) ([]domain.TaskWatcher, error) {
+	if err := s.checkTask(ctx, taskID, userRole, userID); err != nil {
+		return nil, err
+	}
+	watchers, err := s.watcherRepo.GetTaskWatchers(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if watchers == nil {
+		watchers = []domain.TaskWatcher{}
+	}
+	return watchers, nil
+}
+
+// GetWatchedTasks returns the tasks the user watches, leaving out for employees the ones
+// no longer assigned to them
+func (s *service) GetWatchedTasks(ctx context.Context, userRole, userID string) ([]domain.Task, error) {
+	watched, err := s.watcherRepo.GetWatchedTasks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tasks := []domain.Task{}
+	for _, task := range watched {
+		if canRead(task, userRole, userID) {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+// checkTask checks that the task exists and that the caller can read it
+func (s *service) checkTask(ctx context.Context, taskID, userRole, userID string) error {
+	task, err := s.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if !canRead(task, userRole, userID) {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only watch tasks assigned to you")
+	}
+	return nil
+}
+
+// canRead reports whether the caller can read a task: employers read every task, employees
+// the tasks assigned to them
+func canRead(task domain.Task, userRole, userID string) bool {
+	return userRole == string(domain.RoleEmployer) || (task.AssigneeID != nil && *task.AssigneeID == userID)
+}
@@ -0,0 +1,13 @@
+package wipsvc
+
+import "kn-assignment/internal/core/port"
+
+type service struct {
+	wipRepo port.WIPRepository
+	// wipLimit is the default number of In Progress tasks of an employee, 0 for no limit
+	wipLimit int
+}
+
+func New(wipRepo port.WIPRepository, wipLimit int) port.WIPService {
+	return &service{wipRepo: wipRepo, wipLimit: wipLimit}
+}
@@ -0,0 +1,37 @@
+package wipsvc
+
+import (
+	"context"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/log"
+)
+
+// GetWIPLimits returns the default limit of employees and the limit and task count of every status column
+func (s *service) GetWIPLimits(ctx context.Context) (domain.WIPLimits, error) {
+	statuses, err := s.wipRepo.GetStatusWIPLimits(ctx)
+	if err != nil {
+		return domain.WIPLimits{}, err
+	}
+	return domain.WIPLimits{EmployeeLimit: s.wipLimit, Statuses: statuses}, nil
+}
+
+// SetStatusWIPLimit sets how many tasks a status column holds, removing the limit when nil.
+// Tasks already past a new limit stay where they are.
+func (s *service) SetStatusWIPLimit(ctx context.Context, request domain.SetStatusWIPLimitRequest, userID string) error {
+	if !request.Status.IsValid() {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "A valid status is required")
+	}
+	if request.WIPLimit != nil && *request.WIPLimit < 1 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "WIP limit must be at least 1, or null for no limit")
+	}
+	log.Infof(ctx, "Setting the WIP limit of %s", request.Status)
+	return s.wipRepo.SetStatusWIPLimit(ctx, request.Status, request.WIPLimit, userID)
+}
+
+// GetWIPOverrides returns the WIP limits employers overrode for a task, or for all tasks when taskID is empty
+func (s *service) GetWIPOverrides(ctx context.Context, taskID string) ([]domain.WIPOverride, error) {
+	return s.wipRepo.GetWIPOverrides(ctx, taskID)
+}
@@ -0,0 +1,385 @@
+// Package taskquery parses the task search language accepted by GET /tasks?query=.
+//
+// A query is a list of terms separated by whitespace, all of which must match:
+//
+//	status:"In Progress" assignee:alice due<2025-01-01 label:backend -label:blocked
+//
+// A term is either free text, matched against the title and description, or
+// a field, an operator and a value. Values containing spaces are quoted, and a
+// leading "-" negates the term. Custom fields are referred to as cf.<key>; their
+// values are checked against the field definitions by the caller.
+package taskquery
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"kn-assignment/internal/core/domain"
+)
+
+type Field string
+
+const (
+	FieldText     Field = "text"
+	FieldTitle    Field = "title"
+	FieldStatus   Field = "status"
+	FieldAssignee Field = "assignee"
+	FieldCreator  Field = "creator"
+	FieldLabel    Field = "label"
+	FieldDue      Field = "due"
+	FieldCreated  Field = "created"
+	FieldUpdated  Field = "updated"
+	FieldProject  Field = "project"
+	FieldPriority Field = "priority"
+	// FieldCustom terms hold the custom field key in CustomKey
+	FieldCustom Field = "cf"
+)
+
+// CustomFieldPrefix starts the field name of custom fields, as in cf.customer:acme
+const CustomFieldPrefix = "cf."
+
+type Operator string
+
+const (
+	OpMatch        Operator = ":"
+	OpEqual        Operator = "="
+	OpNotEqual     Operator = "!="
+	OpLess         Operator = "<"
+	OpLessEqual    Operator = "<="
+	OpGreater      Operator = ">"
+	OpGreaterEqual Operator = ">="
+)
+
+// AssigneeNone is the assignee value matching unassigned tasks
+const AssigneeNone = "none"
+
+type fieldKind int
+
+const (
+	kindText fieldKind = iota
+	kindEnum
+	kindDate
+	kindCustom
+)
+
+var fields = map[Field]fieldKind{
+	FieldTitle:    kindText,
+	FieldStatus:   kindEnum,
+	FieldAssignee: kindEnum,
+	FieldCreator:  kindEnum,
+	FieldLabel:    kindEnum,
+	FieldDue:      kindDate,
+	FieldCreated:  kindDate,
+	FieldUpdated:  kindDate,
+	FieldProject:  kindEnum,
+	FieldPriority: kindEnum,
+}
+
+var statuses = map[string]domain.TaskStatus{
+	strings.ToLower(string(domain.StatusPending)):    domain.StatusPending,
+	strings.ToLower(string(domain.StatusInProgress)): domain.StatusInProgress,
+	strings.ToLower(string(domain.StatusBlocked)):    domain.StatusBlocked,
+	strings.ToLower(string(domain.StatusInReview)):   domain.StatusInReview,
+	strings.ToLower(string(domain.StatusCompleted)):  domain.StatusCompleted,
+}
+
+// Term is a single condition of a query.
+type Term struct {
+	Negated bool
+	Field   Field
+	Op      Operator
+	Value   string
+	// Time holds the parsed value of date fields. DateOnly is set when the
+	// value was a calendar day, which then covers the whole day.
+	Time     time.Time
+	DateOnly bool
+	// CustomKey is the key of a custom field term. CustomType is left for the
+	// caller to set from the field definition.
+	CustomKey  string
+	CustomType domain.CustomFieldType
+	// Pos is the 1-based column the term starts at.
+	Pos int
+}
+
+// CustomTerms returns pointers to the custom field terms of the query
+func (q *Query) CustomTerms() []*Term {
+	if q == nil {
+		return nil
+	}
+	var terms []*Term
+	for i := range q.Terms {
+		if q.Terms[i].Field == FieldCustom {
+			terms = append(terms, &q.Terms[i])
+		}
+	}
+	return terms
+}
+
+type Query struct {
+	Terms []Term
+}
+
+// HasDueDays reports whether the query matches calendar days of the due date
+func (q *Query) HasDueDays() bool {
+	if q == nil {
+		return false
+	}
+	for _, term := range q.Terms {
+		if term.Field == FieldDue && term.DateOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// InLocation reads the calendar days of due date terms in loc rather than UTC.
+// Other dates are stored without a timezone and keep reading in UTC.
+func (q *Query) InLocation(loc *time.Location) {
+	for i, term := range q.Terms {
+		if term.Field == FieldDue && term.DateOnly {
+			q.Terms[i].Time = time.Date(term.Time.Year(), term.Time.Month(), term.Time.Day(), 0, 0, 0, 0, loc)
+		}
+	}
+}
+
+func (q *Query) IsEmpty() bool {
+	return q == nil || len(q.Terms) == 0
+}
+
+// SyntaxError reports an invalid query and the column it was found at.
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("invalid query at column %d: %s", e.Pos, e.Msg)
+}
+
+// Parse parses a query string. An empty string yields an empty query.
+func Parse(input string) (*Query, error) {
+	p := &parser{input: input}
+	q := &Query{}
+	for {
+		p.skipSpaces()
+		if p.eof() {
+			return q, nil
+		}
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		q.Terms = append(q.Terms, term)
+	}
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) peek() byte {
+	return p.input[p.pos]
+}
+
+func (p *parser) errorf(pos int, format string, args ...any) error {
+	return &SyntaxError{Pos: pos + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) skipSpaces() {
+	for !p.eof() && isSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+func (p *parser) parseTerm() (Term, error) {
+	term := Term{Pos: p.pos + 1}
+
+	if p.peek() == '-' {
+		term.Negated = true
+		p.pos++
+		if p.eof() || isSpace(p.peek()) {
+			return Term{}, p.errorf(p.pos-1, `expected a term after "-"`)
+		}
+	}
+
+	if p.peek() == '"' {
+		value, err := p.parseQuoted()
+		if err != nil {
+			return Term{}, err
+		}
+		term.Field, term.Op, term.Value = FieldText, OpMatch, value
+		return term, nil
+	}
+
+	wordPos := p.pos
+	word := p.parseWord()
+	if word == "" {
+		return Term{}, p.errorf(p.pos, "unexpected character %q", p.peek())
+	}
+	if p.eof() || !isOperator(p.peek()) {
+		term.Field, term.Op, term.Value = FieldText, OpMatch, word
+		return term, nil
+	}
+
+	term.Field = Field(strings.ToLower(word))
+	kind, ok := fields[term.Field]
+	if key, custom := strings.CutPrefix(word, CustomFieldPrefix); custom {
+		if key == "" {
+			return Term{}, p.errorf(wordPos, "expected a custom field key after %q", CustomFieldPrefix)
+		}
+		term.Field, term.CustomKey, kind, ok = FieldCustom, key, kindCustom, true
+	}
+	if !ok {
+		return Term{}, p.errorf(wordPos, "unknown field %q", word)
+	}
+
+	opPos := p.pos
+	op, err := p.parseOperator()
+	if err != nil {
+		return Term{}, err
+	}
+	if !operatorAllowed(kind, op) {
+		return Term{}, p.errorf(opPos, "operator %q is not supported for field %q", op, term.Field)
+	}
+	term.Op = op
+
+	valuePos := p.pos
+	value, err := p.parseValue()
+	if err != nil {
+		return Term{}, err
+	}
+	if value == "" {
+		return Term{}, p.errorf(valuePos, "expected a value after %q", word+string(op))
+	}
+	term.Value = value
+
+	if err := p.checkValue(&term, kind, valuePos); err != nil {
+		return Term{}, err
+	}
+	return term, nil
+}
+
+func (p *parser) parseWord() string {
+	start := p.pos
+	for !p.eof() && !isSpace(p.peek()) && !isOperator(p.peek()) && p.peek() != '"' {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *parser) parseQuoted() (string, error) {
+	start := p.pos
+	p.pos++ // opening quote
+	var sb strings.Builder
+	for !p.eof() {
+		c := p.peek()
+		switch {
+		case c == '\\' && p.pos+1 < len(p.input):
+			sb.WriteByte(p.input[p.pos+1])
+			p.pos += 2
+		case c == '"':
+			p.pos++
+			return sb.String(), nil
+		default:
+			sb.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", p.errorf(start, "unterminated quoted string")
+}
+
+func (p *parser) parseValue() (string, error) {
+	if p.eof() || isSpace(p.peek()) {
+		return "", nil
+	}
+	start := p.pos
+	var value string
+	if p.peek() == '"' {
+		quoted, err := p.parseQuoted()
+		if err != nil {
+			return "", err
+		}
+		value = quoted
+	} else {
+		value = p.parseWord()
+	}
+	if !p.eof() && !isSpace(p.peek()) {
+		return "", p.errorf(p.pos, "unexpected character %q after value %q", p.peek(), p.input[start:p.pos])
+	}
+	return value, nil
+}
+
+func (p *parser) parseOperator() (Operator, error) {
+	start := p.pos
+	c := p.peek()
+	p.pos++
+	if !p.eof() && p.peek() == '=' && c != ':' && c != '=' {
+		p.pos++
+	}
+	switch op := Operator(p.input[start:p.pos]); op {
+	case OpMatch, OpEqual, OpNotEqual, OpLess, OpLessEqual, OpGreater, OpGreaterEqual:
+		return op, nil
+	default:
+		return "", p.errorf(start, "unknown operator %q", op)
+	}
+}
+
+func (p *parser) checkValue(term *Term, kind fieldKind, valuePos int) error {
+	switch {
+	case term.Field == FieldStatus:
+		status, ok := statuses[strings.ToLower(term.Value)]
+		if !ok {
+			return p.errorf(valuePos, "unknown status %q", term.Value)
+		}
+		term.Value = string(status)
+	case term.Field == FieldPriority:
+		term.Value = strings.ToLower(term.Value)
+		if !domain.TaskPriority(term.Value).IsValid() {
+			return p.errorf(valuePos, "unknown priority %q", term.Value)
+		}
+	case term.Field == FieldLabel:
+		term.Value = strings.ToLower(term.Value)
+	case term.Field == FieldProject:
+		term.Value = strings.ToUpper(term.Value)
+	case kind == kindDate:
+		t, dateOnly, ok := parseDate(term.Value)
+		if !ok {
+			return p.errorf(valuePos, "invalid date %q, expected YYYY-MM-DD or RFC 3339", term.Value)
+		}
+		term.Time, term.DateOnly = t, dateOnly
+	}
+	return nil
+}
+
+func parseDate(value string) (time.Time, bool, bool) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, true
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, true
+	}
+	return time.Time{}, false, false
+}
+
+func operatorAllowed(kind fieldKind, op Operator) bool {
+	switch op {
+	case OpMatch, OpEqual, OpNotEqual:
+		return true
+	default:
+		return kind == kindDate || kind == kindCustom
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isOperator(c byte) bool {
+	return c == ':' || c == '=' || c == '!' || c == '<' || c == '>'
+}
@@ -0,0 +1,115 @@
+package taskquery
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []Term
+	}{
+		{"", nil},
+		{"  ", nil},
+		{"report", []Term{{Field: FieldText, Op: OpMatch, Value: "report", Pos: 1}}},
+		{`"quarterly report"`, []Term{{Field: FieldText, Op: OpMatch, Value: "quarterly report", Pos: 1}}},
+		{`status:"in progress" -label:Blocked`, []Term{
+			{Field: FieldStatus, Op: OpMatch, Value: "In Progress", Pos: 1},
+			{Negated: true, Field: FieldLabel, Op: OpMatch, Value: "blocked", Pos: 22},
+		}},
+		{"project:ops priority:HIGH", []Term{
+			{Field: FieldProject, Op: OpMatch, Value: "OPS", Pos: 1},
+			{Field: FieldPriority, Op: OpMatch, Value: "high", Pos: 13},
+		}},
+		{"due<=2024-01-31", []Term{
+			{Field: FieldDue, Op: OpLessEqual, Value: "2024-01-31", Time: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), DateOnly: true, Pos: 1},
+		}},
+		{"cf.points>=3", []Term{{Field: FieldCustom, Op: OpGreaterEqual, Value: "3", CustomKey: "points", Pos: 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			q, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.input, err)
+			}
+			if len(q.Terms) != len(tt.want) {
+				t.Fatalf("Parse(%q) = %+v, want %+v", tt.input, q.Terms, tt.want)
+			}
+			for i, term := range q.Terms {
+				if !term.Time.Equal(tt.want[i].Time) {
+					t.Errorf("term %d time = %v, want %v", i, term.Time, tt.want[i].Time)
+				}
+				term.Time = tt.want[i].Time
+				if term != tt.want[i] {
+					t.Errorf("term %d = %+v, want %+v", i, term, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+	}{
+		{"-", 1},
+		{"foo -", 5},
+		{`"abc`, 1},
+		{`status:"In Progress`, 8},
+		{"bogus:x", 1},
+		{"cf.:x", 1},
+		{"status!x", 7},
+		{"label<x", 6},
+		{"status:", 8},
+		{"status:done", 8},
+		{"priority:extreme", 10},
+		{"due<tomorrow", 5},
+		{`title:"a"b`, 10},
+		{":x", 1},
+		{"a =x", 3},
+		{"due=>2024-01-01", 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parse(%q) error = %v, want a SyntaxError", tt.input, err)
+			}
+			if syntaxErr.Pos != tt.pos {
+				t.Errorf("Parse(%q) error at column %d, want %d: %v", tt.input, syntaxErr.Pos, tt.pos, err)
+			}
+		})
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{
+		"", "report", `"quoted \" text"`, `status:"In Progress" -label:blocked`,
+		`due<=2024-01-31 created>"2024-01-01T10:00:00Z"`, "cf.points>=3 cf.customer:acme",
+		"assignee:none -", `title:"a"b`, "due=>", "!=", `"`, `\`,
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		q, err := Parse(input)
+		if err != nil {
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parse(%q) error = %v, want a SyntaxError", input, err)
+			}
+			if syntaxErr.Pos < 1 || syntaxErr.Pos > len(input)+1 {
+				t.Fatalf("Parse(%q) error at column %d, outside 1..%d", input, syntaxErr.Pos, len(input)+1)
+			}
+			return
+		}
+		for _, term := range q.Terms {
+			if term.Pos < 1 || term.Pos > len(input) {
+				t.Fatalf("Parse(%q) term %+v at column %d, outside 1..%d", input, term, term.Pos, len(input))
+			}
+		}
+	})
+}
@@ -0,0 +1,140 @@
+// Package workday tells the working days of a user apart from their days off: the days outside
+// their working week, the public holidays and their time off, and counts business days over them.
+// Days are local days in the timezone of the user.
+package workday
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"kn-assignment/internal/core/domain"
+)
+
+// maxScanDays bounds the search for working days, e.g. when every weekday is off
+const maxScanDays = 3660
+
+// ErrNoWorkingDay is returned when no working day comes within about ten years
+var ErrNoWorkingDay = errors.New("no working day ahead")
+
+type Calendar struct {
+	loc *time.Location
+	// days are the working weekdays, by time.Weekday
+	days [7]bool
+	// start and end are the working hours, in minutes since midnight
+	start, end int
+	holidays   map[string]string
+	timeOff    map[string]domain.TimeOff
+}
+
+// New builds the calendar of a user. It fails on invalid timezones, hours or days.
+func New(cal domain.WorkCalendar) (Calendar, error) {
+	loc, err := domain.LoadTimezone(cal.Timezone)
+	if err != nil {
+		return Calendar{}, err
+	}
+	start, err := ParseClock(cal.WorkingHours.Start)
+	if err != nil {
+		return Calendar{}, err
+	}
+	end, err := ParseClock(cal.WorkingHours.End)
+	if err != nil {
+		return Calendar{}, err
+	}
+	c := Calendar{
+		loc:      loc,
+		start:    start,
+		end:      end,
+		holidays: make(map[string]string, len(cal.Holidays)),
+		timeOff:  make(map[string]domain.TimeOff),
+	}
+	for _, day := range cal.WorkingHours.Days {
+		if day < 1 || day > 7 {
+			return Calendar{}, fmt.Errorf("invalid weekday %d", day)
+		}
+		// ISO weekdays end with Sunday, the first time.Weekday
+		c.days[time.Weekday(day%7)] = true
+	}
+	for _, holiday := range cal.Holidays {
+		c.holidays[holiday.Date] = holiday.Name
+	}
+	for _, off := range cal.TimeOff {
+		from, err := time.Parse(time.DateOnly, off.StartDate)
+		if err != nil {
+			return Calendar{}, err
+		}
+		to, err := time.Parse(time.DateOnly, off.EndDate)
+		if err != nil {
+			return Calendar{}, err
+		}
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			c.timeOff[day.Format(time.DateOnly)] = off
+		}
+	}
+	return c, nil
+}
+
+// ParseClock returns the minutes since midnight of an HH:MM time of day
+func ParseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (c Calendar) Location() *time.Location {
+	return c.loc
+}
+
+// Day tells whether the user works on the local day of t, and otherwise why not
+func (c Calendar) Day(t time.Time) (bool, string) {
+	date := t.In(c.loc).Format(time.DateOnly)
+	if name, ok := c.holidays[date]; ok {
+		return false, "Holiday: " + name
+	}
+	if off, ok := c.timeOff[date]; ok {
+		if off.Reason == "" {
+			return false, "Time off"
+		}
+		return false, "Time off: " + off.Reason
+	}
+	if !c.days[t.In(c.loc).Weekday()] {
+		return false, "Not a working day"
+	}
+	return true, ""
+}
+
+// TimeOff returns the time off the user takes on the local day of t, if any
+func (c Calendar) TimeOff(t time.Time) (domain.TimeOff, bool) {
+	off, ok := c.timeOff[t.In(c.loc).Format(time.DateOnly)]
+	return off, ok
+}
+
+// Hours returns the start and end of the working hours on the local day of t
+func (c Calendar) Hours(t time.Time) (time.Time, time.Time) {
+	t = t.In(c.loc)
+	y, m, d := t.Date()
+	return time.Date(y, m, d, c.start/60, c.start%60, 0, 0, c.loc), time.Date(y, m, d, c.end/60, c.end%60, 0, 0, c.loc)
+}
+
+// AddBusinessDays returns the end of the working hours of the nth working day after the local
+// day of from, or of the first working day from it on when n is 0
+func (c Calendar) AddBusinessDays(from time.Time, n int) (time.Time, error) {
+	y, m, d := from.In(c.loc).Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, c.loc)
+	if n > 0 {
+		day = day.AddDate(0, 0, 1)
+	}
+	for i := 0; i < maxScanDays; i, day = i+1, day.AddDate(0, 0, 1) {
+		if working, _ := c.Day(day); !working {
+			continue
+		}
+		if n <= 1 {
+			_, end := c.Hours(day)
+			return end, nil
+		}
+		n--
+	}
+	return time.Time{}, ErrNoWorkingDay
+}
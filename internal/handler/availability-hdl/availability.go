@@ -0,0 +1,210 @@
+package availabilityhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get the availability of a user
+// @Description Get the days between from and to, both included, in the timezone of a user with whether they work on them, the hours they work and why they are off: a holiday, their time off or a day outside their working week. The range defaults to four weeks from today and spans at most 366 days. Use me as the user ID for your own availability.
+// @Tags availability
+// @Produce json
+// @Param userID path string true "User ID, or me"
+// @Param from query string false "First day, YYYY-MM-DD"
+// @Param to query string false "Last day, YYYY-MM-DD"
+// @Success 200 {object} domain.Availability
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/{userID}/availability [get]
+func (h *handler) GetAvailability(c *gin.Context) {
+	userID := c.Param("userID")
+	if userID == "me" {
+		userID = c.GetString("userId")
+	}
+	availability, err := h.svc.GetAvailability(c.Request.Context(), userID, c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, availability)
+}
+
+// @Summary Set your working hours
+// @Description Set the weekdays and hours you work on, in your timezone. Tasks of templates with a due offset in business days are due at the end of your working day. Defaults to Monday to Friday, 09:00 to 17:00.
+// @Tags availability
+// @Accept json
+// @Produce json
+// @Param hours body dto.SetWorkingHoursRequest true "Working hours"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/working-hours [put]
+func (h *handler) SetMyWorkingHours(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.SetWorkingHoursRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding working hours: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.SetWorkingHours(ctx, c.GetString("userId"), req.ToDomain()); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Working hours updated successfully"})
+}
+
+// @Summary Take time off
+// @Description Record your time off between two days, both included, in your timezone. It cannot overlap your other time off. Tasks due during it are not assigned to you unless allow_time_off is set.
+// @Tags availability
+// @Accept json
+// @Produce json
+// @Param timeOff body dto.CreateTimeOffRequest true "Time off"
+// @Success 201 {object} domain.TimeOff
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/time-off [post]
+func (h *handler) CreateMyTimeOff(c *gin.Context) {
+	h.createTimeOff(c, c.GetString("userId"))
+}
+
+// @Summary Record the time off of a user
+// @Description Record the time off of a user between two days, both included, in their timezone. It cannot overlap their other time off.
+// @Tags availability
+// @Accept json
+// @Produce json
+// @Param userID path string true "User ID"
+// @Param timeOff body dto.CreateTimeOffRequest true "Time off"
+// @Success 201 {object} domain.TimeOff
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/{userID}/time-off [post]
+func (h *handler) CreateUserTimeOff(c *gin.Context) {
+	h.createTimeOff(c, c.Param("userID"))
+}
+
+func (h *handler) createTimeOff(c *gin.Context, userID string) {
+	ctx := c.Request.Context()
+
+	var req dto.CreateTimeOffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding time off: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	timeOff, err := h.svc.CreateTimeOff(ctx, userID, req.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, timeOff)
+}
+
+// @Summary Delete time off
+// @Description Delete your time off. Employers can delete the time off of anyone.
+// @Tags availability
+// @Produce json
+// @Param timeOffID path string true "Time off ID"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /time-off/{timeOffID} [delete]
+func (h *handler) DeleteTimeOff(c *gin.Context) {
+	if err := h.svc.DeleteTimeOff(c.Request.Context(), c.Param("timeOffID"), c.GetString("role"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Time off deleted successfully"})
+}
+
+// @Summary Get holidays
+// @Description Get the public holidays between from and to, both included, in order. Defaults to the current year.
+// @Tags availability
+// @Produce json
+// @Param from query string false "First day, YYYY-MM-DD"
+// @Param to query string false "Last day, YYYY-MM-DD"
+// @Success 200 {array} domain.Holiday
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /holidays [get]
+func (h *handler) GetHolidays(c *gin.Context) {
+	holidays, err := h.svc.GetHolidays(c.Request.Context(), c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if holidays == nil {
+		holidays = []domain.Holiday{}
+	}
+	c.JSON(http.StatusOK, holidays)
+}
+
+// @Summary Add a holiday
+// @Description Add a public holiday, a day off for everyone. There is at most one holiday a day.
+// @Tags availability
+// @Accept json
+// @Produce json
+// @Param holiday body dto.CreateHolidayRequest true "Holiday"
+// @Success 201 {object} domain.Holiday
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /holidays [post]
+func (h *handler) CreateHoliday(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.CreateHolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding holiday: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	holiday, err := h.svc.CreateHoliday(ctx, req.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, holiday)
+}
+
+// @Summary Delete a holiday
+// @Description Delete the public holiday of a day
+// @Tags availability
+// @Produce json
+// @Param date path string true "Day, YYYY-MM-DD"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /holidays/{date} [delete]
+func (h *handler) DeleteHoliday(c *gin.Context) {
+	if err := h.svc.DeleteHoliday(c.Request.Context(), c.Param("date")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Holiday deleted successfully"})
+}
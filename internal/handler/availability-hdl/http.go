@@ -0,0 +1,28 @@
+package availabilityhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	GetAvailability(c *gin.Context)
+	SetMyWorkingHours(c *gin.Context)
+	CreateMyTimeOff(c *gin.Context)
+	CreateUserTimeOff(c *gin.Context)
+	DeleteTimeOff(c *gin.Context)
+	GetHolidays(c *gin.Context)
+	CreateHoliday(c *gin.Context)
+	DeleteHoliday(c *gin.Context)
+}
+
+type handler struct {
+	svc port.AvailabilityService
+}
+
+func New(svc port.AvailabilityService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
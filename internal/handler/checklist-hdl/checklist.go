@@ -0,0 +1,182 @@
+package checklisthdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get the checklist of a task
+// @Description Get the checklist items of a task in order. Employees can only see checklists of tasks assigned to them.
+// @Tags checklists
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {array} domain.ChecklistItem
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/checklist [get]
+func (h *handler) GetChecklist(c *gin.Context) {
+	items, err := h.svc.GetChecklist(c.Request.Context(), c.Param("taskID"), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if items == nil {
+		items = []domain.ChecklistItem{}
+	}
+	c.JSON(http.StatusOK, items)
+}
+
+// @Summary Add a checklist item
+// @Description Add an item at the bottom of the checklist of a task. Required items must be done before the task can be completed.
+// @Tags checklists
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param item body dto.CreateChecklistItemRequest true "Checklist item"
+// @Success 201 {object} domain.ChecklistItem
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/checklist [post]
+func (h *handler) AddChecklistItem(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var item dto.CreateChecklistItemRequest
+	if err := c.ShouldBindJSON(&item); err != nil {
+		log.Errorf(ctx, "error binding checklist item: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.AddChecklistItem(ctx, c.Param("taskID"), item.ToDomain())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Update a checklist item
+// @Description Update the content of a checklist item or whether it is required
+// @Tags checklists
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param itemID path string true "Checklist item ID"
+// @Param item body dto.UpdateChecklistItemRequest true "Checklist item"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/checklist/{itemID} [patch]
+func (h *handler) UpdateChecklistItem(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var item dto.UpdateChecklistItemRequest
+	if err := c.ShouldBindJSON(&item); err != nil {
+		log.Errorf(ctx, "error binding checklist item: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateChecklistItem(ctx, c.Param("taskID"), c.Param("itemID"), item.ToDomain()); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Checklist item updated successfully"})
+}
+
+// @Summary Tick or untick a checklist item
+// @Description Mark a checklist item as done or not done. Employees can only tick items of tasks assigned to them.
+// @Tags checklists
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param itemID path string true "Checklist item ID"
+// @Param done body dto.SetChecklistItemDoneRequest true "Done"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/checklist/{itemID}/done [patch]
+func (h *handler) SetChecklistItemDone(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var done dto.SetChecklistItemDoneRequest
+	if err := c.ShouldBindJSON(&done); err != nil {
+		log.Errorf(ctx, "error binding checklist item done: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.SetChecklistItemDone(ctx, c.Param("taskID"), c.Param("itemID"), done.Done, c.GetString("role"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Checklist item updated successfully"})
+}
+
+// @Summary Reorder a checklist item
+// @Description Move a checklist item between two other items. Leave previous_item_id empty to move it to the top and next_item_id empty to move it to the bottom.
+// @Tags checklists
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param itemID path string true "Checklist item ID"
+// @Param move body dto.MoveChecklistItemRequest true "Neighbors"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/checklist/{itemID}/move [patch]
+func (h *handler) MoveChecklistItem(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var move dto.MoveChecklistItemRequest
+	if err := c.ShouldBindJSON(&move); err != nil {
+		log.Errorf(ctx, "error binding checklist move: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.MoveChecklistItem(ctx, c.Param("taskID"), c.Param("itemID"), move.ToDomain()); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Checklist item moved successfully"})
+}
+
+// @Summary Delete a checklist item
+// @Description Delete an item from the checklist of a task
+// @Tags checklists
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param itemID path string true "Checklist item ID"
+// @Success 204
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/checklist/{itemID} [delete]
+func (h *handler) DeleteChecklistItem(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.svc.DeleteChecklistItem(ctx, c.Param("taskID"), c.Param("itemID")); err != nil {
+		log.Errorf(ctx, "error deleting checklist item: %v", err)
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
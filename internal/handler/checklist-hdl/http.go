@@ -0,0 +1,26 @@
+package checklisthdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	GetChecklist(c *gin.Context)
+	AddChecklistItem(c *gin.Context)
+	UpdateChecklistItem(c *gin.Context)
+	SetChecklistItemDone(c *gin.Context)
+	MoveChecklistItem(c *gin.Context)
+	DeleteChecklistItem(c *gin.Context)
+}
+
+type handler struct {
+	svc port.ChecklistService
+}
+
+func New(svc port.ChecklistService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
@@ -0,0 +1,120 @@
+package commenthdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Comment on a task
+// @Description Comment on a task in Markdown. Users mentioned with @username are notified, the watchers of the task are told about the comment, and the tasks referenced with #<task id> or #<task key> are linked to the task as relates_to.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param comment body dto.TaskCommentRequest true "Comment"
+// @Success 201 {object} domain.TaskComment
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/comments [post]
+func (h *handler) CreateTaskComment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var comment dto.TaskCommentRequest
+	if err := c.ShouldBindJSON(&comment); err != nil {
+		log.Errorf(ctx, "error binding task comment: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.CreateTaskComment(ctx, c.Param("taskID"), comment.ToDomain(), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get the comments of a task
+// @Description Get the comments of a task, oldest first, with their Markdown body rendered to sanitized HTML in body_html
+// @Tags comments
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {array} domain.TaskComment
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/comments [get]
+func (h *handler) GetTaskComments(c *gin.Context) {
+	comments, err := h.svc.GetTaskComments(c.Request.Context(), c.Param("taskID"), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if comments == nil {
+		comments = []domain.TaskComment{}
+	}
+	c.JSON(http.StatusOK, comments)
+}
+
+// @Summary Edit a comment
+// @Description Edit one of your comments. Only the users and tasks the edit newly mentions or references are notified and linked.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param commentID path string true "Comment ID"
+// @Param comment body dto.TaskCommentRequest true "Comment"
+// @Success 200 {object} domain.TaskComment
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/comments/{commentID} [patch]
+func (h *handler) UpdateTaskComment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var comment dto.TaskCommentRequest
+	if err := c.ShouldBindJSON(&comment); err != nil {
+		log.Errorf(ctx, "error binding task comment: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	updated, err := h.svc.UpdateTaskComment(ctx, c.Param("taskID"), c.Param("commentID"), comment.ToDomain(), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, updated)
+}
+
+// @Summary Delete a comment
+// @Description Delete one of your comments, or any comment for employers. The links created by its references are kept.
+// @Tags comments
+// @Param taskID path string true "Task ID"
+// @Param commentID path string true "Comment ID"
+// @Success 204
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/comments/{commentID} [delete]
+func (h *handler) DeleteTaskComment(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.svc.DeleteTaskComment(ctx, c.Param("taskID"), c.Param("commentID"), c.GetString("role"), c.GetString("userId")); err != nil {
+		log.Errorf(ctx, "error deleting task comment: %v", err)
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,24 @@
+package commenthdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	CreateTaskComment(c *gin.Context)
+	GetTaskComments(c *gin.Context)
+	UpdateTaskComment(c *gin.Context)
+	DeleteTaskComment(c *gin.Context)
+}
+
+type handler struct {
+	svc port.CommentService
+}
+
+func New(svc port.CommentService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
@@ -0,0 +1,114 @@
+package customfieldhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Define a custom field
+// @Description Define a custom field for the tasks of a project, or of every project when project_id is empty. Types are text, number, date, enum, multi_enum and user; enum types need options.
+// @Tags custom fields
+// @Accept json
+// @Produce json
+// @Param field body dto.CreateCustomFieldRequest true "Custom field"
+// @Success 201 {object} domain.CustomField
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /custom-fields [post]
+func (h *handler) CreateCustomField(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var field dto.CreateCustomFieldRequest
+	if err := c.ShouldBindJSON(&field); err != nil {
+		log.Errorf(ctx, "error binding custom field: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.CreateCustomField(ctx, field.ToDomain())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get custom fields
+// @Description Get all custom field definitions, organization fields first
+// @Tags custom fields
+// @Produce json
+// @Success 200 {array} domain.CustomField
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /custom-fields [get]
+func (h *handler) GetCustomFields(c *gin.Context) {
+	fields, err := h.svc.GetCustomFields(c.Request.Context())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if fields == nil {
+		fields = []domain.CustomField{}
+	}
+	c.JSON(http.StatusOK, fields)
+}
+
+// @Summary Update a custom field
+// @Description Update the name, options or required flag of a custom field. Values already stored on tasks are not changed.
+// @Tags custom fields
+// @Accept json
+// @Produce json
+// @Param fieldID path string true "Custom field ID"
+// @Param field body dto.UpdateCustomFieldRequest true "Custom field"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /custom-fields/{fieldID} [patch]
+func (h *handler) UpdateCustomField(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var field dto.UpdateCustomFieldRequest
+	if err := c.ShouldBindJSON(&field); err != nil {
+		log.Errorf(ctx, "error binding custom field: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateCustomField(ctx, c.Param("fieldID"), field.ToDomain()); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Custom field updated successfully"})
+}
+
+// @Summary Delete a custom field
+// @Description Delete a custom field definition and its values on all tasks
+// @Tags custom fields
+// @Produce json
+// @Param fieldID path string true "Custom field ID"
+// @Success 204
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /custom-fields/{fieldID} [delete]
+func (h *handler) DeleteCustomField(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.svc.DeleteCustomField(ctx, c.Param("fieldID")); err != nil {
+		log.Errorf(ctx, "error deleting custom field: %v", err)
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,24 @@
+package customfieldhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	CreateCustomField(c *gin.Context)
+	GetCustomFields(c *gin.Context)
+	UpdateCustomField(c *gin.Context)
+	DeleteCustomField(c *gin.Context)
+}
+
+type handler struct {
+	svc port.CustomFieldService
+}
+
+func New(svc port.CustomFieldService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type SetWorkingHoursRequest struct {
+	// Days are ISO weekdays, from 1 for Monday to 7 for Sunday
+	Days []int `json:"days" example:"1,2,3,4,5"`
+	// Start and End are HH:MM times in your timezone
+	Start string `json:"start" example:"09:00"`
+	End   string `json:"end" example:"17:00"`
+}
+
+func (s *SetWorkingHoursRequest) ToDomain() domain.WorkingHours {
+	return domain.WorkingHours{
+		Days:  s.Days,
+		Start: s.Start,
+		End:   s.End,
+	}
+}
+
+type CreateTimeOffRequest struct {
+	// StartDate and EndDate are both included, in the timezone of the user
+	StartDate string `json:"start_date" example:"2024-08-05"`
+	EndDate   string `json:"end_date" example:"2024-08-16"`
+	Reason    string `json:"reason" example:"Vacation"`
+}
+
+func (s *CreateTimeOffRequest) ToDomain() domain.CreateTimeOffRequest {
+	return domain.CreateTimeOffRequest{
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+		Reason:    s.Reason,
+	}
+}
+
+type CreateHolidayRequest struct {
+	Date string `json:"date" example:"2024-12-25"`
+	Name string `json:"name" example:"Christmas Day"`
+}
+
+func (s *CreateHolidayRequest) ToDomain() domain.CreateHolidayRequest {
+	return domain.CreateHolidayRequest{
+		Date: s.Date,
+		Name: s.Name,
+	}
+}
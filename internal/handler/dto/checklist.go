@@ -0,0 +1,43 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type CreateChecklistItemRequest struct {
+	Content  string `json:"content" example:"Write migration"`
+	Required bool   `json:"required" example:"true"`
+}
+
+func (s *CreateChecklistItemRequest) ToDomain() domain.CreateChecklistItemRequest {
+	return domain.CreateChecklistItemRequest{
+		Content:  s.Content,
+		Required: s.Required,
+	}
+}
+
+type UpdateChecklistItemRequest struct {
+	Content  *string `json:"content,omitempty"`
+	Required *bool   `json:"required,omitempty"`
+}
+
+func (s *UpdateChecklistItemRequest) ToDomain() domain.UpdateChecklistItemRequest {
+	return domain.UpdateChecklistItemRequest{
+		Content:  s.Content,
+		Required: s.Required,
+	}
+}
+
+type SetChecklistItemDoneRequest struct {
+	Done bool `json:"done" example:"true"`
+}
+
+type MoveChecklistItemRequest struct {
+	PreviousItemID string `json:"previous_item_id" example:""`
+	NextItemID     string `json:"next_item_id" example:""`
+}
+
+func (s *MoveChecklistItemRequest) ToDomain() domain.MoveChecklistItemRequest {
+	return domain.MoveChecklistItemRequest{
+		PreviousItemID: s.PreviousItemID,
+		NextItemID:     s.NextItemID,
+	}
+}
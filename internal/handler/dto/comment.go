@@ -0,0 +1,14 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type TaskCommentRequest struct {
+	// Body is Markdown, where @username mentions a user and #<task id> or #<task key> references a task
+	Body string `json:"body" example:"@alice this is caused by #OPS-142"`
+}
+
+func (s *TaskCommentRequest) ToDomain() domain.TaskCommentRequest {
+	return domain.TaskCommentRequest{
+		Body: s.Body,
+	}
+}
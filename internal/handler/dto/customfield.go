@@ -0,0 +1,37 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type CreateCustomFieldRequest struct {
+	Key       string                 `json:"key" example:"story_points"`
+	Name      string                 `json:"name" example:"Story points"`
+	Type      domain.CustomFieldType `json:"type" example:"number"`
+	Options   []string               `json:"options"`
+	Required  bool                   `json:"required" example:"false"`
+	ProjectID *string                `json:"project_id"`
+}
+
+func (s *CreateCustomFieldRequest) ToDomain() domain.CreateCustomFieldRequest {
+	return domain.CreateCustomFieldRequest{
+		Key:       s.Key,
+		Name:      s.Name,
+		Type:      s.Type,
+		Options:   s.Options,
+		Required:  s.Required,
+		ProjectID: s.ProjectID,
+	}
+}
+
+type UpdateCustomFieldRequest struct {
+	Name     *string   `json:"name,omitempty"`
+	Options  *[]string `json:"options,omitempty"`
+	Required *bool     `json:"required,omitempty"`
+}
+
+func (s *UpdateCustomFieldRequest) ToDomain() domain.UpdateCustomFieldRequest {
+	return domain.UpdateCustomFieldRequest{
+		Name:     s.Name,
+		Options:  s.Options,
+		Required: s.Required,
+	}
+}
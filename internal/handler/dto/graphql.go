@@ -0,0 +1,20 @@
+package dto
+
+import "encoding/json"
+
+type GraphQLRequest struct {
+	Query         string                 `json:"query" example:"{ tasks { id title assignee { username } } }"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type GraphQLResponse struct {
+	Data   json.RawMessage `json:"data" swaggertype:"object"`
+	Errors []GraphQLError  `json:"errors,omitempty"`
+}
+
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
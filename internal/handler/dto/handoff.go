@@ -0,0 +1,37 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type CreateHandoffRequest struct {
+	// Type is reassign or decline
+	Type                domain.HandoffType `json:"type" example:"reassign"`
+	Reason              string             `json:"reason" example:"On leave next week"`
+	SuggestedAssigneeID *string            `json:"suggested_assignee_id"`
+}
+
+func (s *CreateHandoffRequest) ToDomain() domain.CreateHandoffRequest {
+	return domain.CreateHandoffRequest{
+		Type:                s.Type,
+		Reason:              s.Reason,
+		SuggestedAssigneeID: s.SuggestedAssigneeID,
+	}
+}
+
+type ResolveHandoffRequest struct {
+	// AssigneeID takes over an approved reassignment, the suggested assignee by default
+	AssigneeID *string `json:"assignee_id"`
+	Note       string  `json:"note" example:"Bob takes it over"`
+	// OverrideNote hands an In Progress task over past the WIP limit of the employee, explaining why
+	OverrideNote string `json:"override_note" example:""`
+	// AllowTimeOff hands the task over even though it is due during the time off of the employee
+	AllowTimeOff bool `json:"allow_time_off" example:"false"`
+}
+
+func (s *ResolveHandoffRequest) ToDomain() domain.ResolveHandoffRequest {
+	return domain.ResolveHandoffRequest{
+		AssigneeID:   s.AssigneeID,
+		Note:         s.Note,
+		OverrideNote: s.OverrideNote,
+		AllowTimeOff: s.AllowTimeOff,
+	}
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type CreateTaskLinkRequest struct {
+	TargetTaskID string `json:"target_task_id" example:"b2f1c8e4-6a3d-4c1e-9f7a-2d5e8b9c0a1f"`
+	// Type is duplicate_of, relates_to, follow_up_of, caused_by or blocked_by, read from the task to the target
+	Type domain.TaskLinkType `json:"type" example:"caused_by"`
+}
+
+func (s *CreateTaskLinkRequest) ToDomain() domain.CreateTaskLinkRequest {
+	return domain.CreateTaskLinkRequest{
+		TargetTaskID: s.TargetTaskID,
+		Type:         s.Type,
+	}
+}
+
+type CloseAsDuplicateRequest struct {
+	OriginalTaskID string `json:"original_task_id" example:"b2f1c8e4-6a3d-4c1e-9f7a-2d5e8b9c0a1f"`
+}
+
+func (s *CloseAsDuplicateRequest) ToDomain() domain.CloseAsDuplicateRequest {
+	return domain.CloseAsDuplicateRequest{
+		OriginalTaskID: s.OriginalTaskID,
+	}
+}
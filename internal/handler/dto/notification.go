@@ -0,0 +1,26 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type NotificationPreference struct {
+	// Type is assigned, status_changed, mention, comment, due_soon, overdue, reviewed, sla_escalated or digest
+	Type  domain.NotificationType `json:"type" example:"status_changed"`
+	InApp bool                    `json:"in_app" example:"true"`
+	Email bool                    `json:"email" example:"false"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []NotificationPreference `json:"preferences"`
+}
+
+func (s *UpdateNotificationPreferencesRequest) ToDomain() []domain.NotificationPreference {
+	preferences := make([]domain.NotificationPreference, 0, len(s.Preferences))
+	for _, preference := range s.Preferences {
+		preferences = append(preferences, domain.NotificationPreference{
+			Type:  preference.Type,
+			InApp: preference.InApp,
+			Email: preference.Email,
+		})
+	}
+	return preferences
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type ClaimTaskRequest struct {
+	// AllowTimeOff claims the task even though it is due during your time off
+	AllowTimeOff bool `json:"allow_time_off" example:"false"`
+}
+
+func (s *ClaimTaskRequest) ToDomain() domain.ClaimTaskRequest {
+	return domain.ClaimTaskRequest{
+		AllowTimeOff: s.AllowTimeOff,
+	}
+}
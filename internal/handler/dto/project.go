@@ -0,0 +1,30 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type CreateProjectRequest struct {
+	Key  string `json:"key" example:"OPS"`
+	Name string `json:"name" example:"Operations"`
+	// ReviewRequired sends completed tasks to review by their creator
+	ReviewRequired bool `json:"review_required" example:"false"`
+}
+
+func (s *CreateProjectRequest) ToDomain() domain.CreateProjectRequest {
+	return domain.CreateProjectRequest{
+		Key:            s.Key,
+		Name:           s.Name,
+		ReviewRequired: s.ReviewRequired,
+	}
+}
+
+type UpdateProjectRequest struct {
+	Name           *string `json:"name,omitempty"`
+	ReviewRequired *bool   `json:"review_required,omitempty"`
+}
+
+func (s *UpdateProjectRequest) ToDomain() domain.UpdateProjectRequest {
+	return domain.UpdateProjectRequest{
+		Name:           s.Name,
+		ReviewRequired: s.ReviewRequired,
+	}
+}
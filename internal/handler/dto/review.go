@@ -0,0 +1,20 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type ReviewTaskRequest struct {
+	// Approved completes the task, otherwise it goes back to In Progress
+	Approved bool `json:"approved" example:"false"`
+	// Feedback is required to send a task back
+	Feedback string `json:"feedback" example:"Please add tests for the edge cases"`
+	// OverrideNote sends a task back past the WIP limit of its assignee or of In Progress, explaining why
+	OverrideNote string `json:"override_note" example:""`
+}
+
+func (s *ReviewTaskRequest) ToDomain() domain.ReviewTaskRequest {
+	return domain.ReviewTaskRequest{
+		Approved:     s.Approved,
+		Feedback:     s.Feedback,
+		OverrideNote: s.OverrideNote,
+	}
+}
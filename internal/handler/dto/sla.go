@@ -0,0 +1,54 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type CreateSLAPolicyRequest struct {
+	Name      string               `json:"name" example:"Customer support"`
+	Labels    []string             `json:"labels" example:"customer"`
+	Priority  *domain.TaskPriority `json:"priority" example:"high"`
+	ProjectID *string              `json:"project_id"`
+	// Precedence orders matching policies, lowest first
+	Precedence int `json:"precedence" example:"0"`
+	// StartOn is created or assigned, created by default
+	StartOn           domain.SLAStart `json:"start_on" example:"created"`
+	ResponseMinutes   *int            `json:"response_minutes" example:"60"`
+	ResolutionMinutes *int            `json:"resolution_minutes" example:"480"`
+	WarnBeforeMinutes int             `json:"warn_before_minutes" example:"30"`
+	// Escalation is notify or reassign, notify by default
+	Escalation domain.SLAEscalation `json:"escalation" example:"notify"`
+}
+
+func (s *CreateSLAPolicyRequest) ToDomain() domain.CreateSLAPolicyRequest {
+	return domain.CreateSLAPolicyRequest{
+		Name:              s.Name,
+		Labels:            s.Labels,
+		Priority:          s.Priority,
+		ProjectID:         s.ProjectID,
+		Precedence:        s.Precedence,
+		StartOn:           s.StartOn,
+		ResponseMinutes:   s.ResponseMinutes,
+		ResolutionMinutes: s.ResolutionMinutes,
+		WarnBeforeMinutes: s.WarnBeforeMinutes,
+		Escalation:        s.Escalation,
+	}
+}
+
+type UpdateSLAPolicyRequest struct {
+	Name              *string               `json:"name,omitempty"`
+	Precedence        *int                  `json:"precedence,omitempty"`
+	ResponseMinutes   *int                  `json:"response_minutes,omitempty"`
+	ResolutionMinutes *int                  `json:"resolution_minutes,omitempty"`
+	WarnBeforeMinutes *int                  `json:"warn_before_minutes,omitempty"`
+	Escalation        *domain.SLAEscalation `json:"escalation,omitempty"`
+}
+
+func (s *UpdateSLAPolicyRequest) ToDomain() domain.UpdateSLAPolicyRequest {
+	return domain.UpdateSLAPolicyRequest{
+		Name:              s.Name,
+		Precedence:        s.Precedence,
+		ResponseMinutes:   s.ResponseMinutes,
+		ResolutionMinutes: s.ResolutionMinutes,
+		WarnBeforeMinutes: s.WarnBeforeMinutes,
+		Escalation:        s.Escalation,
+	}
+}
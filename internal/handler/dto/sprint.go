@@ -0,0 +1,51 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type CreateSprintRequest struct {
+	Name      string `json:"name" example:"Sprint 14"`
+	Goal      string `json:"goal" example:"Ship the new onboarding flow"`
+	StartDate string `json:"start_date" example:"2024-06-03"`
+	EndDate   string `json:"end_date" example:"2024-06-14"`
+}
+
+func (s *CreateSprintRequest) ToDomain() domain.CreateSprintRequest {
+	return domain.CreateSprintRequest{
+		Name:      s.Name,
+		Goal:      s.Goal,
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+	}
+}
+
+type UpdateSprintRequest struct {
+	Name      *string `json:"name,omitempty"`
+	Goal      *string `json:"goal,omitempty"`
+	StartDate *string `json:"start_date,omitempty" example:"2024-06-03"`
+	EndDate   *string `json:"end_date,omitempty" example:"2024-06-14"`
+}
+
+func (s *UpdateSprintRequest) ToDomain() domain.UpdateSprintRequest {
+	return domain.UpdateSprintRequest{
+		Name:      s.Name,
+		Goal:      s.Goal,
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+	}
+}
+
+type CloseSprintRequest struct {
+	// CarryOverSprintID is the sprint the unfinished tasks move to, the backlog when empty
+	CarryOverSprintID *string `json:"carry_over_sprint_id,omitempty"`
+}
+
+func (s *CloseSprintRequest) ToDomain() domain.CloseSprintRequest {
+	return domain.CloseSprintRequest{
+		CarryOverSprintID: s.CarryOverSprintID,
+	}
+}
+
+type AddSprintTasksRequest struct {
+	// TaskIDs are task IDs or keys
+	TaskIDs []string `json:"task_ids" example:"OPS-142,OPS-143"`
+}
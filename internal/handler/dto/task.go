@@ -7,26 +7,133 @@ import (
 
 type AssignTaskRequest struct {
 	AssigneeID string `json:"assignee_id"`
+	// OverrideNote assigns an In Progress task past the WIP limit of the employee, explaining why
+	OverrideNote string `json:"override_note" example:""`
+	// AllowTimeOff assigns the task even though it is due during the time off of the employee
+	AllowTimeOff bool `json:"allow_time_off" example:"false"`
+}
+
+func (s *AssignTaskRequest) ToDomain() domain.AssignTaskRequest {
+	return domain.AssignTaskRequest{
+		AssigneeID:   s.AssigneeID,
+		OverrideNote: s.OverrideNote,
+		AllowTimeOff: s.AllowTimeOff,
+	}
 }
 
 type UpdateTaskStatusRequest struct {
 	Status domain.TaskStatus `json:"status"`
 }
 type CreateTaskRequest struct {
-	Title       string    `json:"title" example:"New Task"`
-	Description string    `json:"description" example:"This is a new task"`
-	DueDate     time.Time `json:"due_date" example:"2024-12-31T23:59:59Z"`
+	Title       string `json:"title" example:"New Task"`
+	Description string `json:"description" example:"This is a new task"`
+	// StartDate is when work is planned to start, by the due date
+	StartDate *time.Time `json:"start_date" example:"2024-12-20T09:00:00Z"`
+	DueDate   time.Time  `json:"due_date" example:"2024-12-31T23:59:59Z"`
+	// Milestone marks a point in time, the due date, and takes no start date
+	Milestone bool     `json:"milestone" example:"false"`
+	Labels    []string `json:"labels" example:"backend,release"`
+	Checklist []string `json:"checklist" example:"Write migration,Update docs"`
+	ProjectID *string  `json:"project_id"`
+	// CustomFields holds values by custom field key
+	CustomFields   map[string]any `json:"custom_fields"`
+	EstimatedHours *float64       `json:"estimated_hours" example:"4"`
+	StoryPoints    *float64       `json:"story_points" example:"3"`
+	// Priority is low, medium, high or urgent, medium by default
+	Priority domain.TaskPriority `json:"priority" example:"medium"`
+	// ReviewRequired sends the completed task to review by its creator, overriding the project
+	ReviewRequired *bool `json:"review_required"`
+	// AutoAssign assigns the task to an employee picked by the server's strategy
+	AutoAssign bool `json:"auto_assign" example:"false"`
 }
 
 func (s *CreateTaskRequest) ToDomain() domain.CreateTaskRequest {
 	return domain.CreateTaskRequest{
-		Title:       s.Title,
-		Description: s.Description,
-		DueDate:     s.DueDate,
+		Title:          s.Title,
+		Description:    s.Description,
+		StartDate:      s.StartDate,
+		DueDate:        s.DueDate,
+		Milestone:      s.Milestone,
+		Labels:         s.Labels,
+		Checklist:      s.Checklist,
+		ProjectID:      s.ProjectID,
+		CustomFields:   s.CustomFields,
+		EstimatedHours: s.EstimatedHours,
+		StoryPoints:    s.StoryPoints,
+		Priority:       s.Priority,
+		ReviewRequired: s.ReviewRequired,
+		AutoAssign:     s.AutoAssign,
+	}
+}
+
+type QuickAddRequest struct {
+	Text string `json:"text" example:"Prepare Q3 report for @alice by next friday 5pm #finance !high"`
+	// Create creates the task, which is otherwise only read back for confirmation
+	Create bool `json:"create" example:"false"`
+	// AllowTimeOff assigns the task even though it is due during the time off of the employee
+	AllowTimeOff bool `json:"allow_time_off" example:"false"`
+}
+
+func (s *QuickAddRequest) ToDomain() domain.QuickAddRequest {
+	return domain.QuickAddRequest{
+		Text:         s.Text,
+		Create:       s.Create,
+		AllowTimeOff: s.AllowTimeOff,
+	}
+}
+
+type MoveTaskRequest struct {
+	Status         domain.TaskStatus `json:"status" example:"In Progress"`
+	PreviousTaskID string            `json:"previous_task_id" example:""`
+	NextTaskID     string            `json:"next_task_id" example:""`
+	// OverrideNote lets an employer move the task past a WIP limit, explaining why
+	OverrideNote string `json:"override_note" example:""`
+}
+
+func (s *MoveTaskRequest) ToDomain() domain.MoveTaskRequest {
+	return domain.MoveTaskRequest{
+		Status:         s.Status,
+		PreviousTaskID: s.PreviousTaskID,
+		NextTaskID:     s.NextTaskID,
+		OverrideNote:   s.OverrideNote,
 	}
 }
 
 type UpdateTaskRequest struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Labels      *[]string `json:"labels,omitempty"`
+	// ProjectID moves the task to another project, or out of its project when empty
+	ProjectID *string `json:"project_id,omitempty"`
+	// CustomFields sets the given values, a null value removes one
+	CustomFields   map[string]any `json:"custom_fields,omitempty"`
+	EstimatedHours *float64       `json:"estimated_hours,omitempty"`
+	StoryPoints    *float64       `json:"story_points,omitempty"`
+	StartDate      *time.Time     `json:"start_date,omitempty"`
+	// Milestone turns the task into a milestone, clearing its start date, or back
+	Milestone      *bool                `json:"milestone,omitempty"`
+	Priority       *domain.TaskPriority `json:"priority,omitempty"`
+	ReviewRequired *bool                `json:"review_required,omitempty"`
+}
+
+func (s *UpdateTaskRequest) ToDomain() domain.UpdateTaskRequest {
+	return domain.UpdateTaskRequest{
+		Title:          s.Name,
+		Description:    s.Description,
+		Labels:         s.Labels,
+		ProjectID:      s.ProjectID,
+		CustomFields:   s.CustomFields,
+		EstimatedHours: s.EstimatedHours,
+		StoryPoints:    s.StoryPoints,
+		StartDate:      s.StartDate,
+		Milestone:      s.Milestone,
+		Priority:       s.Priority,
+		ReviewRequired: s.ReviewRequired,
+	}
+}
+
+// TaskDetail is a task with the links to other tasks the caller can read
+type TaskDetail struct {
+	domain.Task
+	Links []domain.LinkedTask `json:"links"`
 }
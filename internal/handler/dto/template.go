@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"kn-assignment/internal/core/domain"
+	"time"
+)
+
+type CreateTaskTemplateRequest struct {
+	Name           string `json:"name" example:"Employee onboarding"`
+	Title          string `json:"title" example:"Onboard {{name}}"`
+	Description    string `json:"description" example:"Prepare the first week of {{name}} in {{team}}"`
+	DueOffsetHours *int   `json:"due_offset_hours" example:"72"`
+	// DueOffsetBusinessDays sets the due date business days of the assignee ahead, instead of due_offset_hours
+	DueOffsetBusinessDays *int     `json:"due_offset_business_days" example:"3"`
+	AssigneeID            *string  `json:"assignee_id"`
+	Labels                []string `json:"labels" example:"onboarding"`
+	Checklist             []string `json:"checklist" example:"Create {{name}}'s accounts,Order a laptop"`
+}
+
+func (s *CreateTaskTemplateRequest) ToDomain() domain.CreateTaskTemplateRequest {
+	return domain.CreateTaskTemplateRequest{
+		Name:                  s.Name,
+		Title:                 s.Title,
+		Description:           s.Description,
+		DueOffsetHours:        s.DueOffsetHours,
+		DueOffsetBusinessDays: s.DueOffsetBusinessDays,
+		AssigneeID:            s.AssigneeID,
+		Labels:                s.Labels,
+		Checklist:             s.Checklist,
+	}
+}
+
+type UpdateTaskTemplateRequest struct {
+	Name           *string `json:"name,omitempty"`
+	Title          *string `json:"title,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	DueOffsetHours *int    `json:"due_offset_hours,omitempty"`
+	// Setting one due offset clears the other
+	DueOffsetBusinessDays *int      `json:"due_offset_business_days,omitempty"`
+	AssigneeID            *string   `json:"assignee_id,omitempty"`
+	Labels                *[]string `json:"labels,omitempty"`
+	Checklist             *[]string `json:"checklist,omitempty"`
+}
+
+func (s *UpdateTaskTemplateRequest) ToDomain() domain.UpdateTaskTemplateRequest {
+	return domain.UpdateTaskTemplateRequest{
+		Name:                  s.Name,
+		Title:                 s.Title,
+		Description:           s.Description,
+		DueOffsetHours:        s.DueOffsetHours,
+		DueOffsetBusinessDays: s.DueOffsetBusinessDays,
+		AssigneeID:            s.AssigneeID,
+		Labels:                s.Labels,
+		Checklist:             s.Checklist,
+	}
+}
+
+type TemplateInstance struct {
+	Variables  map[string]string `json:"variables"`
+	AssigneeID *string           `json:"assignee_id"`
+	DueDate    *time.Time        `json:"due_date" example:"2024-12-31T23:59:59Z"`
+	// AllowTimeOff assigns the task even though it is due during the time off of the employee
+	AllowTimeOff bool `json:"allow_time_off" example:"false"`
+}
+
+type CreateTasksFromTemplateRequest struct {
+	Tasks []TemplateInstance `json:"tasks"`
+}
+
+func (s *CreateTasksFromTemplateRequest) ToDomain() []domain.TemplateInstance {
+	instances := make([]domain.TemplateInstance, 0, len(s.Tasks))
+	for _, task := range s.Tasks {
+		instances = append(instances, domain.TemplateInstance{
+			Variables:    task.Variables,
+			AssigneeID:   task.AssigneeID,
+			DueDate:      task.DueDate,
+			AllowTimeOff: task.AllowTimeOff,
+		})
+	}
+	return instances
+}
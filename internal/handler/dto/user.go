@@ -0,0 +1,25 @@
+package dto
+
+type UpdateUserSkillsRequest struct {
+	Skills []string `json:"skills" example:"backend,postgres"`
+}
+
+type UpdateClaimLimitRequest struct {
+	// ClaimLimit is the number of pool tasks the employee can hold, null for the default limit
+	ClaimLimit *int `json:"claim_limit" example:"5"`
+}
+
+type UpdateWIPLimitRequest struct {
+	// WIPLimit is the number of tasks the employee can have In Progress, null for the default limit
+	WIPLimit *int `json:"wip_limit" example:"2"`
+}
+
+type UpdateTimezoneRequest struct {
+	// Timezone is an IANA timezone
+	Timezone string `json:"timezone" example:"Europe/Paris"`
+}
+
+type UpdateEmailRequest struct {
+	// Email is where notifications are sent, null or empty to stop emails
+	Email *string `json:"email" example:"jane@example.com"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type CreateTaskViewRequest struct {
+	Name       string                `json:"name" example:"My open tasks"`
+	Visibility domain.ViewVisibility `json:"visibility" example:"private"`
+	IsDefault  bool                  `json:"is_default" example:"false"`
+	Filters    map[string]string     `json:"filters"`
+	Query      string                `json:"query" example:"status:Pending label:backend"`
+	Sort       string                `json:"sort" example:"due_date"`
+	SortOrder  string                `json:"sort_order" example:"asc"`
+	Columns    []string              `json:"columns" example:"title,status,due_date"`
+}
+
+func (s *CreateTaskViewRequest) ToDomain() domain.CreateTaskViewRequest {
+	return domain.CreateTaskViewRequest{
+		Name:       s.Name,
+		Visibility: s.Visibility,
+		IsDefault:  s.IsDefault,
+		Filters:    s.Filters,
+		Query:      s.Query,
+		Sort:       s.Sort,
+		SortOrder:  s.SortOrder,
+		Columns:    s.Columns,
+	}
+}
+
+type UpdateTaskViewRequest struct {
+	Name       *string                `json:"name,omitempty"`
+	Visibility *domain.ViewVisibility `json:"visibility,omitempty"`
+	IsDefault  *bool                  `json:"is_default,omitempty"`
+	Filters    *map[string]string     `json:"filters,omitempty"`
+	Query      *string                `json:"query,omitempty"`
+	Sort       *string                `json:"sort,omitempty"`
+	SortOrder  *string                `json:"sort_order,omitempty"`
+	Columns    *[]string              `json:"columns,omitempty"`
+}
+
+func (s *UpdateTaskViewRequest) ToDomain() domain.UpdateTaskViewRequest {
+	return domain.UpdateTaskViewRequest{
+		Name:       s.Name,
+		Visibility: s.Visibility,
+		IsDefault:  s.IsDefault,
+		Filters:    s.Filters,
+		Query:      s.Query,
+		Sort:       s.Sort,
+		SortOrder:  s.SortOrder,
+		Columns:    s.Columns,
+	}
+}
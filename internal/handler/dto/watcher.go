@@ -0,0 +1,14 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type WatchTaskRequest struct {
+	// Muted keeps watching the task without being notified about it
+	Muted bool `json:"muted" example:"false"`
+}
+
+func (s *WatchTaskRequest) ToDomain() domain.WatchTaskRequest {
+	return domain.WatchTaskRequest{
+		Muted: s.Muted,
+	}
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "kn-assignment/internal/core/domain"
+
+type SetStatusWIPLimitRequest struct {
+	Status domain.TaskStatus `json:"status" example:"In Progress"`
+	// WIPLimit is the number of tasks the status column holds, null for no limit
+	WIPLimit *int `json:"wip_limit" example:"5"`
+}
+
+func (s *SetStatusWIPLimitRequest) ToDomain() domain.SetStatusWIPLimitRequest {
+	return domain.SetStatusWIPLimitRequest{
+		Status:   s.Status,
+		WIPLimit: s.WIPLimit,
+	}
+}
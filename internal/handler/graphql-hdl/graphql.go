@@ -0,0 +1,53 @@
+package graphqlhdl
+
+import (
+	"context"
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+type viewerCtxKey struct{}
+
+// viewer is the authenticated caller, taken from the claims set by AuthMiddleware
+type viewer struct {
+	ID   string
+	Role string
+}
+
+func viewerFromContext(ctx context.Context) viewer {
+	v, _ := ctx.Value(viewerCtxKey{}).(viewer)
+	return v
+}
+
+// Query godoc
+// @Summary GraphQL endpoint
+// @Description Query tasks, users and task summaries in a single request
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param query body dto.GraphQLRequest true "GraphQL query"
+// @Success 200 {object} dto.GraphQLResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /graphql [post]
+func (h *handler) Query(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.GraphQLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding graphql request: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	ctx = context.WithValue(ctx, viewerCtxKey{}, viewer{ID: c.GetString("userId"), Role: c.GetString("role")})
+	ctx = withLoaders(ctx, h.userSvc, h.linkSvc)
+
+	c.JSON(http.StatusOK, h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
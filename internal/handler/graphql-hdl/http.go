@@ -0,0 +1,30 @@
+package graphqlhdl
+
+import (
+	_ "embed"
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+type Handler interface {
+	Query(c *gin.Context)
+}
+
+type handler struct {
+	schema  *graphql.Schema
+	userSvc port.UserService
+	linkSvc port.LinkService
+}
+
+func New(taskService port.TaskService, userService port.UserService, linkService port.LinkService) Handler {
+	return &handler{
+		schema:  graphql.MustParseSchema(schemaString, &resolver{taskSvc: taskService}),
+		userSvc: userService,
+		linkSvc: linkService,
+	}
+}
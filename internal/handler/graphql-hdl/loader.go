@@ -0,0 +1,97 @@
+package graphqlhdl
+
+import (
+	"context"
+
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/port"
+
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+type loadersCtxKey struct{}
+
+// loaders batch the lookups made by nested resolvers within a single request,
+// so a list of tasks resolves all its assignees and creators with one query
+type loaders struct {
+	users *dataloader.Loader[string, *domain.User]
+	links *dataloader.Loader[string, []domain.LinkedTask]
+}
+
+func withLoaders(ctx context.Context, userSvc port.UserService, linkSvc port.LinkService) context.Context {
+	return context.WithValue(ctx, loadersCtxKey{}, &loaders{
+		users: dataloader.NewBatchedLoader(userBatchFn(userSvc)),
+		links: dataloader.NewBatchedLoader(linkBatchFn(linkSvc)),
+	})
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersCtxKey{}).(*loaders)
+}
+
+func userBatchFn(userSvc port.UserService) dataloader.BatchFunc[string, *domain.User] {
+	return func(ctx context.Context, userIDs []string) []*dataloader.Result[*domain.User] {
+		results := make([]*dataloader.Result[*domain.User], len(userIDs))
+
+		users, err := userSvc.GetUsersByIDs(ctx, userIDs)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[*domain.User]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[string]*domain.User, len(users))
+		for i := range users {
+			byID[users[i].ID] = &users[i]
+		}
+		// a missing user resolves to null rather than failing the whole batch
+		for i, userID := range userIDs {
+			results[i] = &dataloader.Result[*domain.User]{Data: byID[userID]}
+		}
+		return results
+	}
+}
+
+func loadUser(ctx context.Context, userID string) (*userResolver, error) {
+	user, err := loadersFromContext(ctx).users.Load(ctx, userID)()
+	if err != nil {
+		return nil, toGraphQLError(err)
+	}
+	if user == nil {
+		return nil, nil
+	}
+	return &userResolver{user: *user}, nil
+}
+
+func linkBatchFn(linkSvc port.LinkService) dataloader.BatchFunc[string, []domain.LinkedTask] {
+	return func(ctx context.Context, taskIDs []string) []*dataloader.Result[[]domain.LinkedTask] {
+		results := make([]*dataloader.Result[[]domain.LinkedTask], len(taskIDs))
+
+		v := viewerFromContext(ctx)
+		links, err := linkSvc.GetLinksByTaskIDs(ctx, taskIDs, v.Role, v.ID)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[[]domain.LinkedTask]{Error: err}
+			}
+			return results
+		}
+
+		for i, taskID := range taskIDs {
+			results[i] = &dataloader.Result[[]domain.LinkedTask]{Data: links[taskID]}
+		}
+		return results
+	}
+}
+
+func loadLinks(ctx context.Context, taskID string) ([]*taskLinkResolver, error) {
+	links, err := loadersFromContext(ctx).links.Load(ctx, taskID)()
+	if err != nil {
+		return nil, toGraphQLError(err)
+	}
+	out := make([]*taskLinkResolver, 0, len(links))
+	for _, link := range links {
+		out = append(out, &taskLinkResolver{link: link})
+	}
+	return out, nil
+}
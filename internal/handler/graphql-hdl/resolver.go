@@ -0,0 +1,244 @@
+package graphqlhdl
+
+import (
+	"context"
+	stderrors "errors"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/port"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+// graphQLError exposes the custom error code under the "extensions" key of a GraphQL error
+type graphQLError struct {
+	code    constant.ErrorCode
+	message string
+}
+
+func (e *graphQLError) Error() string {
+	return e.message
+}
+
+func (e *graphQLError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+func toGraphQLError(err error) error {
+	var customErr *errors.CustomError
+	if stderrors.As(err, &customErr) {
+		return &graphQLError{code: customErr.Code, message: customErr.Message}
+	}
+	return &graphQLError{code: constant.ErrCodeInternalServer, message: constant.ErrMsgInternalServer}
+}
+
+// requireRole applies the same check as middleware.RoleMiddleware
+func requireRole(ctx context.Context, role domain.Role) error {
+	if domain.Role(viewerFromContext(ctx).Role) != role {
+		return &graphQLError{code: constant.ErrCodeForbidden, message: constant.ErrMsgForbidden}
+	}
+	return nil
+}
+
+type resolver struct {
+	taskSvc port.TaskService
+}
+
+type tasksArgs struct {
+	AssigneeID *graphql.ID
+	Status     *string
+	Query      *string
+	Sort       *string
+	Order      *string
+}
+
+func (r *resolver) Tasks(ctx context.Context, args tasksArgs) ([]*taskResolver, error) {
+	v := viewerFromContext(ctx)
+
+	filter := map[string]string{}
+	if args.AssigneeID != nil {
+		filter["assignee_id"] = string(*args.AssigneeID)
+	}
+	if args.Status != nil {
+		filter["status"] = *args.Status
+	}
+	query, sort, order := "", "", "asc"
+	if args.Query != nil {
+		query = *args.Query
+	}
+	if args.Sort != nil {
+		sort = *args.Sort
+	}
+	if args.Order != nil {
+		order = *args.Order
+	}
+
+	tasks, err := r.taskSvc.GetAllTasks(ctx, v.Role, v.ID, filter, query, sort, order)
+	if err != nil {
+		return nil, toGraphQLError(err)
+	}
+	return toTaskResolvers(tasks), nil
+}
+
+func (r *resolver) Task(ctx context.Context, args struct{ ID graphql.ID }) (*taskResolver, error) {
+	v := viewerFromContext(ctx)
+
+	task, err := r.taskSvc.GetTaskByID(ctx, string(args.ID))
+	if err != nil {
+		return nil, toGraphQLError(err)
+	}
+	if domain.Role(v.Role) == domain.RoleEmployee && (task.AssigneeID == nil || *task.AssigneeID != v.ID) {
+		return nil, &graphQLError{code: constant.ErrCodeForbidden, message: "You can only view tasks assigned to you"}
+	}
+	return &taskResolver{task: task}, nil
+}
+
+func (r *resolver) TasksByAssignee(ctx context.Context, args struct{ AssigneeID graphql.ID }) ([]*taskResolver, error) {
+	tasks, err := r.taskSvc.GetTasksByAssignee(ctx, string(args.AssigneeID))
+	if err != nil {
+		return nil, toGraphQLError(err)
+	}
+	return toTaskResolvers(tasks), nil
+}
+
+func (r *resolver) TaskSummary(ctx context.Context) ([]*taskSummaryResolver, error) {
+	if err := requireRole(ctx, domain.RoleEmployer); err != nil {
+		return nil, err
+	}
+
+	summaries, err := r.taskSvc.GetTaskSummary(ctx)
+	if err != nil {
+		return nil, toGraphQLError(err)
+	}
+	out := make([]*taskSummaryResolver, 0, len(summaries))
+	for _, summary := range summaries {
+		out = append(out, &taskSummaryResolver{summary: summary})
+	}
+	return out, nil
+}
+
+func (r *resolver) Me(ctx context.Context) (*userResolver, error) {
+	user, err := loadUser(ctx, viewerFromContext(ctx).ID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, &graphQLError{code: constant.ErrCodeNotFound, message: "User not found"}
+	}
+	return user, nil
+}
+
+func (r *resolver) User(ctx context.Context, args struct{ ID graphql.ID }) (*userResolver, error) {
+	if err := requireRole(ctx, domain.RoleEmployer); err != nil {
+		return nil, err
+	}
+	return loadUser(ctx, string(args.ID))
+}
+
+type taskResolver struct {
+	task domain.Task
+}
+
+func toTaskResolvers(tasks []domain.Task) []*taskResolver {
+	out := make([]*taskResolver, 0, len(tasks))
+	for _, task := range tasks {
+		out = append(out, &taskResolver{task: task})
+	}
+	return out
+}
+
+func (t *taskResolver) ID() graphql.ID          { return graphql.ID(t.task.ID) }
+func (t *taskResolver) Key() string             { return t.task.Key }
+func (t *taskResolver) Title() string           { return t.task.Title }
+func (t *taskResolver) Description() string     { return t.task.Description }
+func (t *taskResolver) DescriptionHTML() string { return t.task.DescriptionHTML }
+func (t *taskResolver) Status() string          { return string(t.task.Status) }
+func (t *taskResolver) DueDate() graphql.Time   { return graphql.Time{Time: t.task.DueDate} }
+func (t *taskResolver) CreatedAt() graphql.Time { return graphql.Time{Time: t.task.CreatedAt} }
+func (t *taskResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: t.task.UpdatedAt} }
+func (t *taskResolver) Labels() []string        { return t.task.Labels }
+func (t *taskResolver) ChecklistTotal() int32   { return int32(t.task.ChecklistTotal) }
+func (t *taskResolver) ChecklistDone() int32    { return int32(t.task.ChecklistDone) }
+func (t *taskResolver) Priority() string        { return string(t.task.Priority) }
+func (t *taskResolver) HandoffNote() *string    { return t.task.HandoffNote }
+func (t *taskResolver) ReviewRequired() *bool   { return t.task.ReviewRequired }
+func (t *taskResolver) Pooled() bool            { return t.task.Pooled }
+func (t *taskResolver) AssigneeID() *graphql.ID {
+	if t.task.AssigneeID == nil {
+		return nil
+	}
+	id := graphql.ID(*t.task.AssigneeID)
+	return &id
+}
+
+func (t *taskResolver) Assignee(ctx context.Context) (*userResolver, error) {
+	if t.task.AssigneeID == nil {
+		return nil, nil
+	}
+	return loadUser(ctx, *t.task.AssigneeID)
+}
+
+func (t *taskResolver) CreatedBy(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, t.task.CreatedBy)
+}
+
+func (t *taskResolver) UpdatedBy(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, t.task.UpdatedBy)
+}
+
+func (t *taskResolver) Links(ctx context.Context) ([]*taskLinkResolver, error) {
+	return loadLinks(ctx, t.task.ID)
+}
+
+type taskLinkResolver struct {
+	link domain.LinkedTask
+}
+
+func (l *taskLinkResolver) LinkID() graphql.ID      { return graphql.ID(l.link.LinkID) }
+func (l *taskLinkResolver) Type() string            { return string(l.link.Type) }
+func (l *taskLinkResolver) Outgoing() bool          { return l.link.Outgoing }
+func (l *taskLinkResolver) Label() string           { return l.link.Label }
+func (l *taskLinkResolver) TaskID() graphql.ID      { return graphql.ID(l.link.TaskID) }
+func (l *taskLinkResolver) Title() string           { return l.link.Title }
+func (l *taskLinkResolver) Status() string          { return string(l.link.Status) }
+func (l *taskLinkResolver) CreatedAt() graphql.Time { return graphql.Time{Time: l.link.CreatedAt} }
+
+type userResolver struct {
+	user domain.User
+}
+
+func (u *userResolver) ID() graphql.ID          { return graphql.ID(u.user.ID) }
+func (u *userResolver) Username() string        { return u.user.Username }
+func (u *userResolver) Role() string            { return string(u.user.Role) }
+func (u *userResolver) Timezone() string        { return u.user.Timezone }
+func (u *userResolver) CreatedAt() graphql.Time { return graphql.Time{Time: u.user.CreatedAt} }
+func (u *userResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: u.user.UpdatedAt} }
+
+type taskSummaryResolver struct {
+	summary domain.TaskSummary
+}
+
+func (s *taskSummaryResolver) EmployeeID() graphql.ID    { return graphql.ID(s.summary.EmployeeID) }
+func (s *taskSummaryResolver) TotalTasks() int32         { return int32(s.summary.TotalTasks) }
+func (s *taskSummaryResolver) CompletedTasks() int32     { return int32(s.summary.CompletedTasks) }
+func (s *taskSummaryResolver) SlaTasks() int32           { return int32(s.summary.SLATasks) }
+func (s *taskSummaryResolver) ResponseBreaches() int32   { return int32(s.summary.ResponseBreaches) }
+func (s *taskSummaryResolver) ResolutionBreaches() int32 { return int32(s.summary.ResolutionBreaches) }
+func (s *taskSummaryResolver) ApprovedReviews() int32    { return int32(s.summary.ApprovedReviews) }
+func (s *taskSummaryResolver) RejectedReviews() int32    { return int32(s.summary.RejectedReviews) }
+func (s *taskSummaryResolver) InProgressTasks() int32    { return int32(s.summary.InProgressTasks) }
+func (s *taskSummaryResolver) OverWipLimit() bool        { return s.summary.OverWIPLimit }
+
+func (s *taskSummaryResolver) WipLimit() *int32 {
+	if s.summary.WIPLimit == nil {
+		return nil
+	}
+	limit := int32(*s.summary.WIPLimit)
+	return &limit
+}
+
+func (s *taskSummaryResolver) Employee(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, s.summary.EmployeeID)
+}
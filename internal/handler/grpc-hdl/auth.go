@@ -0,0 +1,42 @@
+package grpchdl
+
+import (
+	"context"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	authv1 "kn-assignment/pkg/pb/auth/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *authServer) RegisterUser(ctx context.Context, req *authv1.RegisterUserRequest) (*authv1.RegisterUserResponse, error) {
+	user := domain.CreateUserRequest{
+		Username: req.GetUsername(),
+		Password: req.GetPassword(),
+		Role:     domain.Role(req.GetRole()),
+	}
+	if err := s.svc.RegisterUser(ctx, user); err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &authv1.RegisterUserResponse{Message: "User registered successfully"}, nil
+}
+
+func (s *authServer) AuthenticateUser(ctx context.Context, req *authv1.AuthenticateUserRequest) (*authv1.AuthenticateUserResponse, error) {
+	response, err := s.svc.AuthenticateUser(ctx, req.GetUsername(), req.GetPassword())
+	if err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &authv1.AuthenticateUserResponse{
+		User: &authv1.User{
+			Id:        response.User.ID,
+			Username:  response.User.Username,
+			Role:      string(response.User.Role),
+			CreatedAt: timestamppb.New(response.User.CreatedAt),
+			UpdatedAt: timestamppb.New(response.User.UpdatedAt),
+			Timezone:  response.User.Timezone,
+			Email:     response.User.Email,
+		},
+		AccessToken:  response.AccessToken,
+		RefreshToken: response.RefreshToken,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package grpchdl
+
+import (
+	"kn-assignment/internal/core/port"
+	authv1 "kn-assignment/pkg/pb/auth/v1"
+	taskv1 "kn-assignment/pkg/pb/task/v1"
+)
+
+type taskServer struct {
+	taskv1.UnimplementedTaskServiceServer
+	svc port.TaskService
+}
+
+func NewTaskServer(svc port.TaskService) taskv1.TaskServiceServer {
+	return &taskServer{
+		svc: svc,
+	}
+}
+
+type authServer struct {
+	authv1.UnimplementedAuthServiceServer
+	svc port.AuthService
+}
+
+func NewAuthServer(svc port.AuthService) authv1.AuthServiceServer {
+	return &authServer{
+		svc: svc,
+	}
+}
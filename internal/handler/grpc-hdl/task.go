@@ -0,0 +1,174 @@
+package grpchdl
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/middleware"
+	taskv1 "kn-assignment/pkg/pb/task/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *taskServer) CreateTask(ctx context.Context, req *taskv1.CreateTaskRequest) (*taskv1.CreateTaskResponse, error) {
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	task := domain.CreateTaskRequest{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Labels:      req.GetLabels(),
+	}
+	if req.GetDueDate() != nil {
+		task.DueDate = req.GetDueDate().AsTime()
+	}
+
+	if err := s.svc.CreateTask(ctx, task, claims.Id); err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &taskv1.CreateTaskResponse{Message: string(constant.SuccessMessage_Created)}, nil
+}
+
+func (s *taskServer) AssignTask(ctx context.Context, req *taskv1.AssignTaskRequest) (*taskv1.AssignTaskResponse, error) {
+	claims, _ := middleware.ClaimsFromContext(ctx)
+	if err := s.svc.AssignTask(ctx, req.GetTaskId(), domain.AssignTaskRequest{
+		AssigneeID:   req.GetAssigneeId(),
+		OverrideNote: req.GetOverrideNote(),
+		AllowTimeOff: req.GetAllowTimeOff(),
+	}, claims.Id); err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &taskv1.AssignTaskResponse{Message: "Task assigned successfully"}, nil
+}
+
+func (s *taskServer) GetTasksByAssignee(ctx context.Context, req *taskv1.GetTasksByAssigneeRequest) (*taskv1.GetTasksByAssigneeResponse, error) {
+	tasks, err := s.svc.GetTasksByAssignee(ctx, req.GetAssigneeId())
+	if err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &taskv1.GetTasksByAssigneeResponse{Tasks: toPbTasks(tasks)}, nil
+}
+
+func (s *taskServer) UpdateTaskStatus(ctx context.Context, req *taskv1.UpdateTaskStatusRequest) (*taskv1.UpdateTaskStatusResponse, error) {
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	isAssigned, err := s.svc.VerifyTaskAssignment(ctx, req.GetTaskId(), claims.Id)
+	if err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	if !isAssigned {
+		return nil, errors.GRPCError(errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only update tasks assigned to you"))
+	}
+
+	if err := s.svc.UpdateTaskStatus(ctx, req.GetTaskId(), domain.TaskStatus(req.GetStatus()), claims.Id); err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &taskv1.UpdateTaskStatusResponse{Message: "Task status updated successfully"}, nil
+}
+
+func (s *taskServer) GetAllTasks(ctx context.Context, req *taskv1.GetAllTasksRequest) (*taskv1.GetAllTasksResponse, error) {
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	filter := map[string]string{}
+	if req.GetAssigneeId() != "" {
+		filter["assignee_id"] = req.GetAssigneeId()
+	}
+	if req.GetStatus() != "" {
+		filter["status"] = req.GetStatus()
+	}
+	order := req.GetOrder()
+	if order == "" {
+		order = "asc"
+	}
+
+	tasks, err := s.svc.GetAllTasks(ctx, claims.Role, claims.Id, filter, req.GetQuery(), req.GetSort(), order)
+	if err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &taskv1.GetAllTasksResponse{Tasks: toPbTasks(tasks)}, nil
+}
+
+func (s *taskServer) GetTaskSummary(ctx context.Context, _ *taskv1.GetTaskSummaryRequest) (*taskv1.GetTaskSummaryResponse, error) {
+	summaries, err := s.svc.GetTaskSummary(ctx)
+	if err != nil {
+		return nil, errors.GRPCError(err)
+	}
+
+	resp := &taskv1.GetTaskSummaryResponse{Summaries: make([]*taskv1.TaskSummary, 0, len(summaries))}
+	for _, summary := range summaries {
+		var wipLimit *int64
+		if summary.WIPLimit != nil {
+			limit := int64(*summary.WIPLimit)
+			wipLimit = &limit
+		}
+		resp.Summaries = append(resp.Summaries, &taskv1.TaskSummary{
+			EmployeeId:         summary.EmployeeID,
+			TotalTasks:         int64(summary.TotalTasks),
+			CompletedTasks:     int64(summary.CompletedTasks),
+			SlaTasks:           int64(summary.SLATasks),
+			ResponseBreaches:   int64(summary.ResponseBreaches),
+			ResolutionBreaches: int64(summary.ResolutionBreaches),
+			ApprovedReviews:    int64(summary.ApprovedReviews),
+			RejectedReviews:    int64(summary.RejectedReviews),
+			InProgressTasks:    int64(summary.InProgressTasks),
+			WipLimit:           wipLimit,
+			OverWipLimit:       summary.OverWIPLimit,
+		})
+	}
+	return resp, nil
+}
+
+func (s *taskServer) UpdateTask(ctx context.Context, req *taskv1.UpdateTaskRequest) (*taskv1.UpdateTaskResponse, error) {
+	claims, _ := middleware.ClaimsFromContext(ctx)
+	task := domain.UpdateTaskRequest{
+		Title:       req.Name,
+		Description: req.Description,
+	}
+	if req.GetLabels() != nil {
+		task.Labels = &req.GetLabels().Values
+	}
+
+	if err := s.svc.UpdateTask(ctx, req.GetTaskId(), task, claims.Id); err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &taskv1.UpdateTaskResponse{Message: "Task updated successfully"}, nil
+}
+
+func (s *taskServer) DeleteTask(ctx context.Context, req *taskv1.DeleteTaskRequest) (*taskv1.DeleteTaskResponse, error) {
+	if err := s.svc.DeleteTask(ctx, req.GetTaskId()); err != nil {
+		return nil, errors.GRPCError(err)
+	}
+	return &taskv1.DeleteTaskResponse{}, nil
+}
+
+func toPbTasks(tasks []domain.Task) []*taskv1.Task {
+	out := make([]*taskv1.Task, 0, len(tasks))
+	for _, task := range tasks {
+		out = append(out, toPbTask(task))
+	}
+	return out
+}
+
+func toPbTask(task domain.Task) *taskv1.Task {
+	return &taskv1.Task{
+		Id:              task.ID,
+		Key:             task.Key,
+		Title:           task.Title,
+		Description:     task.Description,
+		AssigneeId:      task.AssigneeID,
+		Status:          string(task.Status),
+		CreatedAt:       timestamppb.New(task.CreatedAt),
+		CreatedBy:       task.CreatedBy,
+		UpdatedAt:       timestamppb.New(task.UpdatedAt),
+		UpdatedBy:       task.UpdatedBy,
+		DueDate:         timestamppb.New(task.DueDate),
+		Labels:          task.Labels,
+		ChecklistTotal:  int32(task.ChecklistTotal),
+		ChecklistDone:   int32(task.ChecklistDone),
+		Priority:        string(task.Priority),
+		HandoffNote:     task.HandoffNote,
+		ReviewRequired:  task.ReviewRequired,
+		Pooled:          task.Pooled,
+		DescriptionHtml: task.DescriptionHTML,
+	}
+}
@@ -0,0 +1,162 @@
+package handoffhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Request a handoff
+// @Description Ask to reassign a task assigned to you, optionally suggesting who takes it over, or to decline it. A reason is required and a task has at most one pending request.
+// @Tags handoffs
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param request body dto.CreateHandoffRequest true "Handoff request"
+// @Success 201 {object} domain.HandoffRequest
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/handoffs [post]
+func (h *handler) RequestHandoff(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var request dto.CreateHandoffRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Errorf(ctx, "error binding handoff request: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.RequestHandoff(ctx, c.Param("taskID"), request.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get the handoffs of a task
+// @Description Get the handoff requests of a task, latest first. Employees can only read the handoffs of tasks assigned to them.
+// @Tags handoffs
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {array} domain.HandoffRequest
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/handoffs [get]
+func (h *handler) GetTaskHandoffs(c *gin.Context) {
+	requests, err := h.svc.GetTaskHandoffs(c.Request.Context(), c.Param("taskID"), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if requests == nil {
+		requests = []domain.HandoffRequest{}
+	}
+	c.JSON(http.StatusOK, requests)
+}
+
+// @Summary Get handoff requests
+// @Description Get the handoff requests with a status, oldest first. Defaults to the pending requests.
+// @Tags handoffs
+// @Produce json
+// @Param status query string false "Status" Enums("pending", "approved", "rejected")
+// @Success 200 {array} domain.HandoffRequest
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /handoffs [get]
+func (h *handler) GetHandoffRequests(c *gin.Context) {
+	requests, err := h.svc.GetHandoffRequests(c.Request.Context(), domain.HandoffStatus(c.Query("status")))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if requests == nil {
+		requests = []domain.HandoffRequest{}
+	}
+	c.JSON(http.StatusOK, requests)
+}
+
+// @Summary Approve a handoff request
+// @Description Approve a pending handoff request. A reassigned task goes to assignee_id, or the suggested assignee; a declined task is left unassigned. The reason of the request is kept on the task as its handoff note.
+// @Tags handoffs
+// @Accept json
+// @Produce json
+// @Param requestID path string true "Handoff request ID"
+// @Param resolution body dto.ResolveHandoffRequest false "Resolution"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /handoffs/{requestID}/approve [patch]
+func (h *handler) ApproveHandoff(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var resolution dto.ResolveHandoffRequest
+	if !bindResolution(c, &resolution) {
+		return
+	}
+
+	if err := h.svc.ApproveHandoff(ctx, c.Param("requestID"), resolution.ToDomain(), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Handoff request approved"})
+}
+
+// @Summary Reject a handoff request
+// @Description Reject a pending handoff request, leaving the task with the requester
+// @Tags handoffs
+// @Accept json
+// @Produce json
+// @Param requestID path string true "Handoff request ID"
+// @Param resolution body dto.ResolveHandoffRequest false "Resolution"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /handoffs/{requestID}/reject [patch]
+func (h *handler) RejectHandoff(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var resolution dto.ResolveHandoffRequest
+	if !bindResolution(c, &resolution) {
+		return
+	}
+
+	if err := h.svc.RejectHandoff(ctx, c.Param("requestID"), resolution.ToDomain(), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Handoff request rejected"})
+}
+
+// bindResolution binds the optional resolution body and responds when it is invalid
+func bindResolution(c *gin.Context, resolution *dto.ResolveHandoffRequest) bool {
+	if c.Request.ContentLength == 0 {
+		return true
+	}
+	if err := c.ShouldBindJSON(resolution); err != nil {
+		log.Errorf(c.Request.Context(), "error binding handoff resolution: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,25 @@
+package handoffhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	RequestHandoff(c *gin.Context)
+	GetTaskHandoffs(c *gin.Context)
+	GetHandoffRequests(c *gin.Context)
+	ApproveHandoff(c *gin.Context)
+	RejectHandoff(c *gin.Context)
+}
+
+type handler struct {
+	svc port.HandoffService
+}
+
+func New(svc port.HandoffService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
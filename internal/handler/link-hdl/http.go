@@ -0,0 +1,24 @@
+package linkhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	CreateTaskLink(c *gin.Context)
+	GetTaskLinks(c *gin.Context)
+	DeleteTaskLink(c *gin.Context)
+	CloseAsDuplicate(c *gin.Context)
+}
+
+type handler struct {
+	svc port.LinkService
+}
+
+func New(svc port.LinkService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
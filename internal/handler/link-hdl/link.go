@@ -0,0 +1,122 @@
+package linkhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Link a task
+// @Description Link a task to another one as duplicate_of, relates_to, follow_up_of, caused_by or blocked_by. Two tasks are linked at most once per type, and blocked_by links cannot loop. Employees can only link tasks assigned to them.
+// @Tags links
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param link body dto.CreateTaskLinkRequest true "Link"
+// @Success 201 {object} domain.TaskLink
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/links [post]
+func (h *handler) CreateTaskLink(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var link dto.CreateTaskLinkRequest
+	if err := c.ShouldBindJSON(&link); err != nil {
+		log.Errorf(ctx, "error binding task link: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.CreateTaskLink(ctx, c.Param("taskID"), link.ToDomain(), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get the links of a task
+// @Description Get the links of a task in both directions, each labeled from the task, e.g. "duplicated by". Employees can only read the links of tasks assigned to them, and only see the links to other tasks assigned to them.
+// @Tags links
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {array} domain.LinkedTask
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/links [get]
+func (h *handler) GetTaskLinks(c *gin.Context) {
+	links, err := h.svc.GetTaskLinks(c.Request.Context(), c.Param("taskID"), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if links == nil {
+		links = []domain.LinkedTask{}
+	}
+	c.JSON(http.StatusOK, links)
+}
+
+// @Summary Remove a link of a task
+// @Description Remove a link from either of its tasks. Employees can only unlink tasks assigned to them.
+// @Tags links
+// @Param taskID path string true "Task ID"
+// @Param linkID path string true "Link ID"
+// @Success 204
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/links/{linkID} [delete]
+func (h *handler) DeleteTaskLink(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.svc.DeleteTaskLink(ctx, c.Param("taskID"), c.Param("linkID"), c.GetString("role"), c.GetString("userId")); err != nil {
+		log.Errorf(ctx, "error deleting task link: %v", err)
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Close a task as duplicate
+// @Description Complete a task and link it as a duplicate of the original task, skipping its review and required checklist items. Only employers can close tasks as duplicates.
+// @Tags links
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param request body dto.CloseAsDuplicateRequest true "Original task"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/close-as-duplicate [post]
+func (h *handler) CloseAsDuplicate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var request dto.CloseAsDuplicateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		log.Errorf(ctx, "error binding close as duplicate request: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.CloseAsDuplicate(ctx, c.Param("taskID"), request.ToDomain(), c.GetString("role"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task closed as duplicate"})
+}
@@ -0,0 +1,25 @@
+package notificationhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	GetNotifications(c *gin.Context)
+	MarkNotificationRead(c *gin.Context)
+	MarkAllNotificationsRead(c *gin.Context)
+	GetNotificationPreferences(c *gin.Context)
+	UpdateNotificationPreferences(c *gin.Context)
+}
+
+type handler struct {
+	svc port.NotificationService
+}
+
+func New(svc port.NotificationService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
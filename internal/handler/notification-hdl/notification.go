@@ -0,0 +1,123 @@
+package notificationhdl
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"kn-assignment/internal/constant"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get your notifications
+// @Description Get your latest notifications, newest first, with the number of unread notifications
+// @Tags notifications
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param limit query int false "Maximum number of notifications (default 50, at most 200)"
+// @Success 200 {object} domain.NotificationInbox
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /notifications [get]
+func (h *handler) GetNotifications(c *gin.Context) {
+	unreadOnly, _ := strconv.ParseBool(c.Query("unread"))
+	limit := 0
+	if l := c.Query("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 0 {
+			c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Limit must be a positive number"))
+			return
+		}
+	}
+
+	inbox, err := h.svc.GetNotifications(c.Request.Context(), c.GetString("userId"), unreadOnly, limit)
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, inbox)
+}
+
+// @Summary Mark a notification read
+// @Tags notifications
+// @Produce json
+// @Param notificationID path string true "Notification ID"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/{notificationID}/read [patch]
+func (h *handler) MarkNotificationRead(c *gin.Context) {
+	if err := h.svc.MarkNotificationRead(c.Request.Context(), c.Param("notificationID"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Notification marked read"})
+}
+
+// @Summary Mark all notifications read
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} dto.BaseResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/read-all [patch]
+func (h *handler) MarkAllNotificationsRead(c *gin.Context) {
+	marked, err := h.svc.MarkAllNotificationsRead(c.Request.Context(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: fmt.Sprintf("%d notifications marked read", marked)})
+}
+
+// @Summary Get your notification preferences
+// @Description Get whether each type of notification is shown in your inbox and emailed to you
+// @Tags notifications
+// @Produce json
+// @Success 200 {array} domain.NotificationPreference
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/preferences [get]
+func (h *handler) GetNotificationPreferences(c *gin.Context) {
+	preferences, err := h.svc.GetNotificationPreferences(c.Request.Context(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, preferences)
+}
+
+// @Summary Set your notification preferences
+// @Description Set whether the given types of notification are shown in your inbox and emailed to you. Other types keep their preference. The digest is only sent by email.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param preferences body dto.UpdateNotificationPreferencesRequest true "Preferences"
+// @Success 200 {array} domain.NotificationPreference
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /notifications/preferences [put]
+func (h *handler) UpdateNotificationPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding notification preferences: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	preferences, err := h.svc.UpdateNotificationPreferences(ctx, c.GetString("userId"), req.ToDomain())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, preferences)
+}
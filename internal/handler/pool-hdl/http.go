@@ -0,0 +1,25 @@
+package poolhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	GetPoolTasks(c *gin.Context)
+	PublishTask(c *gin.Context)
+	WithdrawTask(c *gin.Context)
+	ClaimTask(c *gin.Context)
+	ReleaseTask(c *gin.Context)
+}
+
+type handler struct {
+	svc port.PoolService
+}
+
+func New(svc port.PoolService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
@@ -0,0 +1,123 @@
+package poolhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get the task pool
+// @Description Get the unassigned tasks published to the pool, most urgent and oldest first
+// @Tags pool
+// @Produce json
+// @Success 200 {array} domain.Task
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /pool [get]
+func (h *handler) GetPoolTasks(c *gin.Context) {
+	tasks, err := h.svc.GetPoolTasks(c.Request.Context())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if tasks == nil {
+		tasks = []domain.Task{}
+	}
+	c.JSON(http.StatusOK, tasks)
+}
+
+// @Summary Publish a task to the pool
+// @Description Let employees claim an unassigned task
+// @Tags pool
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/pool [put]
+func (h *handler) PublishTask(c *gin.Context) {
+	if err := h.svc.PublishTask(c.Request.Context(), c.Param("taskID"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task published to the pool"})
+}
+
+// @Summary Withdraw a task from the pool
+// @Description Stop employees from claiming a task. A claimed task stays with its assignee.
+// @Tags pool
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/pool [delete]
+func (h *handler) WithdrawTask(c *gin.Context) {
+	if err := h.svc.WithdrawTask(c.Request.Context(), c.Param("taskID"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task withdrawn from the pool"})
+}
+
+// @Summary Claim a pool task
+// @Description Assign an open pool task to yourself. The first claim wins, and an employee holds at most their claim limit of claimed tasks. A task due during your time off needs allow_time_off.
+// @Tags pool
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param claim body dto.ClaimTaskRequest false "Time off"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /pool/{taskID}/claim [post]
+func (h *handler) ClaimTask(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.ClaimTaskRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Errorf(ctx, "error binding claim: %v", err)
+			c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+			return
+		}
+	}
+
+	if err := h.svc.ClaimTask(ctx, c.Param("taskID"), req.ToDomain(), c.GetString("role"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task claimed"})
+}
+
+// @Summary Release a claimed task
+// @Description Give a task you claimed back to the pool
+// @Tags pool
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /pool/{taskID}/release [post]
+func (h *handler) ReleaseTask(c *gin.Context) {
+	if err := h.svc.ReleaseTask(c.Request.Context(), c.Param("taskID"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task released to the pool"})
+}
@@ -0,0 +1,24 @@
+package projecthdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	CreateProject(c *gin.Context)
+	GetProjects(c *gin.Context)
+	GetProject(c *gin.Context)
+	UpdateProject(c *gin.Context)
+}
+
+type handler struct {
+	svc port.ProjectService
+}
+
+func New(svc port.ProjectService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
@@ -0,0 +1,112 @@
+package projecthdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create a project
+// @Description Create a project with a unique key of 2 to 10 letters or digits
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param project body dto.CreateProjectRequest true "Project"
+// @Success 201 {object} domain.Project
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /projects [post]
+func (h *handler) CreateProject(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var project dto.CreateProjectRequest
+	if err := c.ShouldBindJSON(&project); err != nil {
+		log.Errorf(ctx, "error binding project: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.CreateProject(ctx, project.ToDomain())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get projects
+// @Description Get all projects ordered by key
+// @Tags projects
+// @Produce json
+// @Success 200 {array} domain.Project
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /projects [get]
+func (h *handler) GetProjects(c *gin.Context) {
+	projects, err := h.svc.GetProjects(c.Request.Context())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if projects == nil {
+		projects = []domain.Project{}
+	}
+	c.JSON(http.StatusOK, projects)
+}
+
+// @Summary Get a project
+// @Description Get a project by ID
+// @Tags projects
+// @Produce json
+// @Param projectID path string true "Project ID"
+// @Success 200 {object} domain.Project
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /projects/{projectID} [get]
+func (h *handler) GetProject(c *gin.Context) {
+	project, err := h.svc.GetProject(c.Request.Context(), c.Param("projectID"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, project)
+}
+
+// @Summary Update a project
+// @Description Rename a project or change whether its completed tasks go to review. The key of a project cannot change.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param projectID path string true "Project ID"
+// @Param project body dto.UpdateProjectRequest true "Project"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /projects/{projectID} [patch]
+func (h *handler) UpdateProject(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var project dto.UpdateProjectRequest
+	if err := c.ShouldBindJSON(&project); err != nil {
+		log.Errorf(ctx, "error binding project: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateProject(ctx, c.Param("projectID"), project.ToDomain()); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Project updated successfully"})
+}
@@ -0,0 +1,22 @@
+package reviewhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	ReviewTask(c *gin.Context)
+	GetTaskReviews(c *gin.Context)
+}
+
+type handler struct {
+	svc port.ReviewService
+}
+
+func New(svc port.ReviewService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
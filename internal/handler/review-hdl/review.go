@@ -0,0 +1,69 @@
+package reviewhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Review a task
+// @Description Accept a task in review, completing it, or send it back to In Progress with feedback, past the WIP limits with an override note. Only the creator of the task can review it.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param review body dto.ReviewTaskRequest true "Review"
+// @Success 201 {object} domain.TaskReview
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/reviews [post]
+func (h *handler) ReviewTask(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var review dto.ReviewTaskRequest
+	if err := c.ShouldBindJSON(&review); err != nil {
+		log.Errorf(ctx, "error binding review: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.ReviewTask(ctx, c.Param("taskID"), review.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get the reviews of a task
+// @Description Get the review outcomes of a task, latest first. Employees can only read the reviews of tasks assigned to them.
+// @Tags reviews
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {array} domain.TaskReview
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/reviews [get]
+func (h *handler) GetTaskReviews(c *gin.Context) {
+	reviews, err := h.svc.GetTaskReviews(c.Request.Context(), c.Param("taskID"), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if reviews == nil {
+		reviews = []domain.TaskReview{}
+	}
+	c.JSON(http.StatusOK, reviews)
+}
@@ -0,0 +1,25 @@
+package slahdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	CreateSLAPolicy(c *gin.Context)
+	GetSLAPolicies(c *gin.Context)
+	UpdateSLAPolicy(c *gin.Context)
+	DeleteSLAPolicy(c *gin.Context)
+	GetTaskSLA(c *gin.Context)
+}
+
+type handler struct {
+	svc port.SLAService
+}
+
+func New(svc port.SLAService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
@@ -0,0 +1,133 @@
+package slahdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create an SLA policy
+// @Description Create an SLA policy with response and resolution targets in minutes. It applies to tasks having any of its labels, its priority and its project; empty criteria match every task, and a task follows the first matching policy by precedence. Clocks start when a task is created or assigned, per start_on.
+// @Tags sla
+// @Accept json
+// @Produce json
+// @Param policy body dto.CreateSLAPolicyRequest true "SLA policy"
+// @Success 201 {object} domain.SLAPolicy
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sla-policies [post]
+func (h *handler) CreateSLAPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var policy dto.CreateSLAPolicyRequest
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		log.Errorf(ctx, "error binding SLA policy: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.CreateSLAPolicy(ctx, policy.ToDomain())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get SLA policies
+// @Description Get all SLA policies in the order they are matched against tasks
+// @Tags sla
+// @Produce json
+// @Success 200 {array} domain.SLAPolicy
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sla-policies [get]
+func (h *handler) GetSLAPolicies(c *gin.Context) {
+	policies, err := h.svc.GetSLAPolicies(c.Request.Context())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if policies == nil {
+		policies = []domain.SLAPolicy{}
+	}
+	c.JSON(http.StatusOK, policies)
+}
+
+// @Summary Update an SLA policy
+// @Description Update the name, precedence, targets or escalation of an SLA policy. New targets apply to clocks started afterwards.
+// @Tags sla
+// @Accept json
+// @Produce json
+// @Param policyID path string true "SLA policy ID"
+// @Param policy body dto.UpdateSLAPolicyRequest true "SLA policy"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sla-policies/{policyID} [patch]
+func (h *handler) UpdateSLAPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var policy dto.UpdateSLAPolicyRequest
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		log.Errorf(ctx, "error binding SLA policy: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateSLAPolicy(ctx, c.Param("policyID"), policy.ToDomain()); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "SLA policy updated successfully"})
+}
+
+// @Summary Delete an SLA policy
+// @Description Delete an SLA policy. Clocks it started are kept for the breach stats but no longer escalate.
+// @Tags sla
+// @Produce json
+// @Param policyID path string true "SLA policy ID"
+// @Success 204
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sla-policies/{policyID} [delete]
+func (h *handler) DeleteSLAPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.svc.DeleteSLAPolicy(ctx, c.Param("policyID")); err != nil {
+		log.Errorf(ctx, "error deleting SLA policy: %v", err)
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Get the SLA of a task
+// @Description Get the SLA clock of a task: its due dates, when targets were met, whether it is paused and which targets were breached. Employees can only read the SLA of tasks assigned to them.
+// @Tags sla
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {object} domain.TaskSLA
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/sla [get]
+func (h *handler) GetTaskSLA(c *gin.Context) {
+	sla, err := h.svc.GetTaskSLA(c.Request.Context(), c.Param("taskID"), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, sla)
+}
@@ -0,0 +1,30 @@
+package sprinthdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	CreateSprint(c *gin.Context)
+	GetSprints(c *gin.Context)
+	GetSprint(c *gin.Context)
+	UpdateSprint(c *gin.Context)
+	DeleteSprint(c *gin.Context)
+	StartSprint(c *gin.Context)
+	CloseSprint(c *gin.Context)
+	AddSprintTasks(c *gin.Context)
+	RemoveSprintTask(c *gin.Context)
+	GetSprintBurndown(c *gin.Context)
+}
+
+type handler struct {
+	svc port.SprintService
+}
+
+func New(svc port.SprintService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
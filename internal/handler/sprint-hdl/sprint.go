@@ -0,0 +1,259 @@
+package sprinthdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create a sprint
+// @Description Plan a sprint between two days, both included. Sprints start planned.
+// @Tags sprints
+// @Accept json
+// @Produce json
+// @Param sprint body dto.CreateSprintRequest true "Sprint"
+// @Success 201 {object} domain.Sprint
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints [post]
+func (h *handler) CreateSprint(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var sprint dto.CreateSprintRequest
+	if err := c.ShouldBindJSON(&sprint); err != nil {
+		log.Errorf(ctx, "error binding sprint: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.CreateSprint(ctx, sprint.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get sprints
+// @Description Get sprints with the count and story points of their tasks, the latest first
+// @Tags sprints
+// @Produce json
+// @Param status query string false "Status" Enums(planned, active, closed)
+// @Success 200 {array} domain.Sprint
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints [get]
+func (h *handler) GetSprints(c *gin.Context) {
+	sprints, err := h.svc.GetSprints(c.Request.Context(), domain.SprintStatus(c.Query("status")))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if sprints == nil {
+		sprints = []domain.Sprint{}
+	}
+	c.JSON(http.StatusOK, sprints)
+}
+
+// @Summary Get a sprint
+// @Description Get a sprint by ID. Its tasks are listed by GET /tasks?sprint={sprintID}.
+// @Tags sprints
+// @Produce json
+// @Param sprintID path string true "Sprint ID"
+// @Success 200 {object} domain.Sprint
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints/{sprintID} [get]
+func (h *handler) GetSprint(c *gin.Context) {
+	sprint, err := h.svc.GetSprint(c.Request.Context(), c.Param("sprintID"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, sprint)
+}
+
+// @Summary Update a sprint
+// @Description Change the name, goal or days of a sprint that is not closed
+// @Tags sprints
+// @Accept json
+// @Produce json
+// @Param sprintID path string true "Sprint ID"
+// @Param sprint body dto.UpdateSprintRequest true "Sprint"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints/{sprintID} [patch]
+func (h *handler) UpdateSprint(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var sprint dto.UpdateSprintRequest
+	if err := c.ShouldBindJSON(&sprint); err != nil {
+		log.Errorf(ctx, "error binding sprint: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateSprint(ctx, c.Param("sprintID"), sprint.ToDomain()); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Sprint updated successfully"})
+}
+
+// @Summary Delete a sprint
+// @Description Delete a planned sprint. Its tasks go back to the backlog.
+// @Tags sprints
+// @Produce json
+// @Param sprintID path string true "Sprint ID"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints/{sprintID} [delete]
+func (h *handler) DeleteSprint(c *gin.Context) {
+	if err := h.svc.DeleteSprint(c.Request.Context(), c.Param("sprintID")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Sprint deleted successfully"})
+}
+
+// @Summary Start a sprint
+// @Description Make a planned sprint active
+// @Tags sprints
+// @Produce json
+// @Param sprintID path string true "Sprint ID"
+// @Success 200 {object} domain.Sprint
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints/{sprintID}/start [post]
+func (h *handler) StartSprint(c *gin.Context) {
+	sprint, err := h.svc.StartSprint(c.Request.Context(), c.Param("sprintID"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, sprint)
+}
+
+// @Summary Close a sprint
+// @Description Close an active sprint. Its completed tasks stay in it and its unfinished tasks are carried over to another sprint that is not closed, or go back to the backlog.
+// @Tags sprints
+// @Accept json
+// @Produce json
+// @Param sprintID path string true "Sprint ID"
+// @Param close body dto.CloseSprintRequest false "Carry-over"
+// @Success 200 {object} domain.CloseSprintResult
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints/{sprintID}/close [post]
+func (h *handler) CloseSprint(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.CloseSprintRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Errorf(ctx, "error binding close: %v", err)
+			c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+			return
+		}
+	}
+
+	result, err := h.svc.CloseSprint(ctx, c.Param("sprintID"), req.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// @Summary Add tasks to a sprint
+// @Description Move tasks, by ID or key, to a sprint that is not closed, out of the sprint they were in if any
+// @Tags sprints
+// @Accept json
+// @Produce json
+// @Param sprintID path string true "Sprint ID"
+// @Param tasks body dto.AddSprintTasksRequest true "Tasks"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints/{sprintID}/tasks [post]
+func (h *handler) AddSprintTasks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.AddSprintTasksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding sprint tasks: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.AddSprintTasks(ctx, c.Param("sprintID"), req.TaskIDs, c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Tasks added to the sprint successfully"})
+}
+
+// @Summary Remove a task from a sprint
+// @Description Move a task of a sprint that is not closed back to the backlog
+// @Tags sprints
+// @Produce json
+// @Param sprintID path string true "Sprint ID"
+// @Param taskID path string true "Task ID"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints/{sprintID}/tasks/{taskID} [delete]
+func (h *handler) RemoveSprintTask(c *gin.Context) {
+	if err := h.svc.RemoveSprintTask(c.Request.Context(), c.Param("sprintID"), c.Param("taskID"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task removed from the sprint successfully"})
+}
+
+// @Summary Get the burndown of a sprint
+// @Description Get every day of a sprint with its scope, completed and remaining story points at the end of the day in a timezone, computed from the status history of its tasks. The scope and completed points draw a burnup chart, the remaining and ideal remaining points a burndown chart. Days to come have no actual values.
+// @Tags sprints
+// @Produce json
+// @Param sprintID path string true "Sprint ID"
+// @Param tz query string false "IANA timezone, the timezone of the user by default" example(Europe/Paris)
+// @Success 200 {object} domain.SprintBurndown
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /sprints/{sprintID}/burndown [get]
+func (h *handler) GetSprintBurndown(c *gin.Context) {
+	burndown, err := h.svc.GetSprintBurndown(c.Request.Context(), c.Param("sprintID"), c.Query("tz"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, burndown)
+}
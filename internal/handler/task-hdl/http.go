@@ -8,6 +8,8 @@ import (
 
 type Handler interface {
 	CreateTask(c *gin.Context)
+	QuickAddTask(c *gin.Context)
+	GetTask(c *gin.Context)
 	GetTasksByAssignee(c *gin.Context)
 	UpdateTaskStatus(c *gin.Context)
 	GetAllTasks(c *gin.Context)
@@ -15,14 +17,23 @@ type Handler interface {
 	AssignTask(c *gin.Context)
 	UpdateTask(c *gin.Context)
 	DeleteTask(c *gin.Context)
+	MoveTask(c *gin.Context)
+	SuggestAssignees(c *gin.Context)
+	GetTaskCalendar(c *gin.Context)
+	GetTaskTimeline(c *gin.Context)
+	ResolveTaskKey(c *gin.Context)
 }
 
 type handler struct {
-	svc port.TaskService
+	svc     port.TaskService
+	viewSvc port.TaskViewService
+	linkSvc port.LinkService
 }
 
-func New(svc port.TaskService) Handler {
+func New(svc port.TaskService, viewSvc port.TaskViewService, linkSvc port.LinkService) Handler {
 	return &handler{
-		svc: svc,
+		svc:     svc,
+		viewSvc: viewSvc,
+		linkSvc: linkSvc,
 	}
 }
@@ -1,11 +1,15 @@
 package taskhdl
 
 import (
+	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"kn-assignment/internal/constant"
 	"kn-assignment/internal/core/domain"
 	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/taskquery"
 	"kn-assignment/internal/handler/dto"
 	"kn-assignment/internal/log"
 
@@ -36,14 +40,50 @@ func (h *handler) CreateTask(c *gin.Context) {
 	userId := c.GetString("userId")
 
 	if err := h.svc.CreateTask(ctx, task.ToDomain(), userId); err != nil {
-		c.JSON(http.StatusInternalServerError, err)
+		c.JSON(errors.StatusCode(err), err)
 		return
 	}
 	c.JSON(http.StatusCreated, task)
 }
 
+// @Summary Quick add a task
+// @Description Read a task from a line of text such as "Prepare Q3 report for @alice by next friday 5pm #finance !high": @username is the assignee, #label a label, !priority the priority, and the due date follows by, due, on or at, as today, tomorrow, friday, next friday, next week, in 3 days, in 3 business days, in 4 hours or 2024-09-30, optionally with a time such as 5pm or 17:00. Dates are read in your timezone, at the end of your working hours when no time is given, and business days skip your days off. The task is checked as by POST /tasks and its assignment, and returned for confirmation, or created with create set.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param task body dto.QuickAddRequest true "Quick add"
+// @Success 200 {object} domain.QuickAddResult
+// @Success 201 {object} domain.QuickAddResult
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/quick [post]
+func (h *handler) QuickAddTask(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.QuickAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding quick add: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	result, err := h.svc.QuickAddTask(ctx, req.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if result.Task != nil {
+		c.JSON(http.StatusCreated, result)
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
 // @Summary Assign a task to an employee
-// @Description Assign a task to an employee
+// @Description Assign a task to an employee. Assigning an In Progress task to an employee at their WIP limit takes an override note, kept for audit, and assigning a task due during the time off of the employee takes allow_time_off.
 // @Tags tasks
 // @Accept json
 // @Produce json
@@ -51,6 +91,7 @@ func (h *handler) CreateTask(c *gin.Context) {
 // @Param assigneeID body dto.AssignTaskRequest true "Assignee ID"
 // @Success 200
 // @Failure 400 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
 // @Failure 500 {object} errors.ErrorResponse
 // @Security BearerAuth
 // @Router /tasks/{taskID}/assign [patch]
@@ -66,13 +107,63 @@ func (h *handler) AssignTask(c *gin.Context) {
 
 	taskID := c.Param("taskID")
 
-	if err := h.svc.AssignTask(ctx, taskID, assignee.AssigneeID); err != nil {
-		c.JSON(http.StatusInternalServerError, err)
+	if err := h.svc.AssignTask(ctx, taskID, assignee.ToDomain(), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
 		return
 	}
 	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task assigned successfully"})
 }
 
+// @Summary Get a task
+// @Description Get a task by its ID or by one of its keys, such as OPS-142, with its links. Employees can only get tasks assigned to them, and only see the links to other tasks assigned to them.
+// @Tags tasks
+// @Produce json
+// @Param taskID path string true "Task ID or key"
+// @Success 200 {object} dto.TaskDetail
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID} [get]
+func (h *handler) GetTask(c *gin.Context) {
+	task, err := h.svc.GetTaskByID(c.Request.Context(), c.Param("taskID"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if c.GetString("role") == string(domain.RoleEmployee) && (task.AssigneeID == nil || *task.AssigneeID != c.GetString("userId")) {
+		c.JSON(http.StatusForbidden, errors.NewCustomErrorWithMessage(constant.ErrCodeForbidden, "You can only view tasks assigned to you"))
+		return
+	}
+	links, err := h.linkSvc.GetLinksByTaskIDs(c.Request.Context(), []string{task.ID}, c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.TaskDetail{Task: task, Links: append([]domain.LinkedTask{}, links[task.ID]...)})
+}
+
+// ResolveTaskKey lets the routes of a task take one of its keys, such as OPS-142, in place of its
+// ID: it replaces a key in the taskID path parameter with the ID of its task
+func (h *handler) ResolveTaskKey(c *gin.Context) {
+	ref := c.Param("taskID")
+	if !domain.IsTaskKey(ref) {
+		c.Next()
+		return
+	}
+	taskID, err := h.svc.ResolveTaskID(c.Request.Context(), ref)
+	if err != nil {
+		c.AbortWithStatusJSON(errors.StatusCode(err), err)
+		return
+	}
+	for i := range c.Params {
+		if c.Params[i].Key == "taskID" {
+			c.Params[i].Value = taskID
+		}
+	}
+	c.Next()
+}
+
 // @Summary Get tasks by assignee
 // @Description Get tasks assigned to a specific user
 // @Tags tasks
@@ -96,7 +187,7 @@ func (h *handler) GetTasksByAssignee(c *gin.Context) {
 }
 
 // @Summary Update task status
-// @Description Update the status of a specific task
+// @Description Update the status of a specific task. A task cannot enter a status column at its WIP limit, nor go In Progress when its assignee is at their WIP limit.
 // @Tags tasks
 // @Accept json
 // @Produce json
@@ -104,6 +195,7 @@ func (h *handler) GetTasksByAssignee(c *gin.Context) {
 // @Param status body dto.UpdateTaskStatusRequest true "Status"
 // @Success 200 body dto.BaseResponse
 // @Failure 400 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
 // @Failure 500 {object} errors.ErrorResponse
 // @Security BearerAuth
 // @Router /tasks/{taskID}/status [patch]
@@ -130,7 +222,7 @@ func (h *handler) UpdateTaskStatus(c *gin.Context) {
 		return
 	}
 	if err := h.svc.UpdateTaskStatus(ctx, taskID, status.Status, userId); err != nil {
-		c.JSON(http.StatusInternalServerError, err)
+		c.JSON(errors.StatusCode(err), err)
 		return
 	}
 	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task status updated successfully"})
@@ -138,14 +230,20 @@ func (h *handler) UpdateTaskStatus(c *gin.Context) {
 
 // GetAllTasks godoc
 // @Summary Get all tasks
-// @Description Get all tasks with optional filtering and sorting
+// @Description Get all tasks with optional filtering and sorting. When a saved view is given, its filters, query and sort apply unless overridden by the other parameters, and the tasks only hold its columns besides their ID. Employees without filters get the default view, if any.
 // @Tags tasks
 // @Produce json
 // @Param assignee query string false "Assignee ID"
-// @Param status query string false "Status" Enums("Pending", "In progress", "Completed")
-// @Param sort query string false "Sort by field (e.g., created_at, due_date, status)"
+// @Param status query string false "Status" Enums("Pending", "In progress", "Blocked", "Completed")
+// @Param priority query string false "Priority" Enums("low", "medium", "high", "urgent")
+// @Param sort query string false "Sort by field (e.g., created_at, due_date, status, priority, rank, or cf.<key> for a custom field)"
 // @Param order query string false "Sort order (asc or desc)"
+// @Param project query string false "Project ID"
+// @Param sprint query string false "Sprint ID"
+// @Param view query string false "Saved view ID"
+// @Param query query string false "Search query, e.g. status:\"In Progress\" assignee:alice due<2025-01-01 label:backend -label:blocked cf.story_points>=3"
 // @Success 200 {array} domain.Task
+// @Failure 400 {object} errors.ErrorResponse
 // @Failure 500 {object} errors.ErrorResponse
 // @Security BearerAuth
 // @Router /tasks [get]
@@ -155,27 +253,129 @@ func (h *handler) GetAllTasks(c *gin.Context) {
 	userRole := c.GetString("role")
 	userID := c.GetString("userId")
 	filter := map[string]string{}
-	if assignee := c.Query("assignee"); assignee != "" {
-		filter["assignee_id"] = assignee
+	query, sort, order := "", "", "asc" // Default to ascending order if not specified
+
+	view, err := h.taskView(c, userRole, userID)
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
 	}
-	if status := c.Query("status"); status != "" {
-		filter["status"] = status
+	if view != nil {
+		for k, v := range view.Filters {
+			filter[k] = v
+		}
+		query, sort, order = view.Query, view.Sort, view.SortOrder
+	}
+
+	queryFilter(c, filter)
+	if q, ok := c.GetQuery("query"); ok {
+		query = q
 	}
-	sort := c.Query("sort")
-	order := c.DefaultQuery("order", "asc") // Default to ascending order if not specified
-	tasks, err := h.svc.GetAllTasks(ctx, userRole, userID, filter, sort, order)
+	if s, ok := c.GetQuery("sort"); ok {
+		sort = s
+	}
+	if o, ok := c.GetQuery("order"); ok {
+		order = o
+	}
+	tasks, err := h.svc.GetAllTasks(ctx, userRole, userID, filter, query, sort, order)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, err)
+		c.JSON(errors.StatusCode(err), err)
 		return
 	}
 	if tasks == nil {
 		tasks = []domain.Task{}
 	}
+	if view != nil && len(view.Columns) > 0 {
+		rows, err := selectColumns(tasks, view.Columns)
+		if err != nil {
+			log.Errorf(ctx, "error selecting the columns of view %s: %v", view.ID, err)
+			c.JSON(http.StatusInternalServerError, errors.NewCustomError(constant.ErrCodeInternalServer))
+			return
+		}
+		c.JSON(http.StatusOK, rows)
+		return
+	}
 	c.JSON(http.StatusOK, tasks)
 }
 
+// taskView returns the saved view the task list runs: the view given, or the default view
+// for employees without filters, if any
+func (h *handler) taskView(c *gin.Context, userRole, userID string) (*domain.TaskView, error) {
+	ctx := c.Request.Context()
+	if viewID := c.Query("view"); viewID != "" {
+		view, err := h.viewSvc.GetTaskView(ctx, viewID, userID)
+		if err != nil {
+			return nil, err
+		}
+		return &view, nil
+	}
+	filter := map[string]string{}
+	queryFilter(c, filter)
+	if userRole != string(domain.RoleEmployee) || len(filter) > 0 || c.Query("query") != "" {
+		return nil, nil
+	}
+	return h.viewSvc.GetDefaultTaskView(ctx)
+}
+
+// selectColumns keeps the given columns of each task besides its ID. A custom field column,
+// cf.<key>, keeps the value of the field in custom_fields, along with the custom_fields column.
+func selectColumns(tasks []domain.Task, columns []string) ([]map[string]any, error) {
+	rows := make([]map[string]any, len(tasks))
+	for i, task := range tasks {
+		data, err := json.Marshal(task)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]any
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+		row := map[string]any{"id": task.ID}
+		customFields := map[string]any{}
+		for _, column := range columns {
+			if column == "custom_fields" {
+				for key, value := range task.CustomFields {
+					customFields[key] = value
+				}
+				row[column] = customFields
+				continue
+			}
+			key, ok := strings.CutPrefix(column, taskquery.CustomFieldPrefix)
+			if !ok {
+				row[column] = fields[column]
+				continue
+			}
+			if value, ok := task.CustomFields[key]; ok {
+				customFields[key] = value
+			}
+			row["custom_fields"] = customFields
+		}
+		rows[i] = row
+	}
+	return rows, nil
+}
+
+// queryFilter adds the task filters of the query parameters to a filter
+func queryFilter(c *gin.Context, filter map[string]string) {
+	if assignee := c.Query("assignee"); assignee != "" {
+		filter["assignee_id"] = assignee
+	}
+	if status := c.Query("status"); status != "" {
+		filter["status"] = status
+	}
+	if priority := c.Query("priority"); priority != "" {
+		filter["priority"] = priority
+	}
+	if project := c.Query("project"); project != "" {
+		filter["project_id"] = project
+	}
+	if sprint := c.Query("sprint"); sprint != "" {
+		filter["sprint_id"] = sprint
+	}
+}
+
 // @Summary Get task summary
-// @Description Get a summary of tasks for each employee
+// @Description Get a summary of tasks for each employee, with the ones having more tasks In Progress than their WIP limit flagged over_wip_limit
 // @Tags tasks
 // @Produce json
 // @Success 200 {array} domain.TaskSummary
@@ -194,6 +394,65 @@ func (h *handler) GetTaskSummary(c *gin.Context) {
 	c.JSON(http.StatusOK, summaries)
 }
 
+// @Summary Get the task calendar
+// @Description Get every day between from and to, both included, with the tasks due on it in a timezone. The range defaults to the current month, or to the end of the month of from when only from is given, and spans at most 366 days. Employees only see tasks assigned to them.
+// @Tags tasks
+// @Produce json
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param tz query string false "IANA timezone, the timezone of the user by default" example(Europe/Paris)
+// @Param assignee_id query string false "Filter by assignee ID (employers only)"
+// @Param counts_only query bool false "Only count the tasks of each day"
+// @Success 200 {object} domain.TaskCalendar
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/calendar [get]
+func (h *handler) GetTaskCalendar(c *gin.Context) {
+	countsOnly, _ := strconv.ParseBool(c.Query("counts_only"))
+	request := domain.TaskCalendarRequest{
+		From:       c.Query("from"),
+		To:         c.Query("to"),
+		Timezone:   c.Query("tz"),
+		AssigneeID: c.Query("assignee_id"),
+		CountsOnly: countsOnly,
+	}
+
+	calendar, err := h.svc.GetTaskCalendar(c.Request.Context(), c.GetString("role"), c.GetString("userId"), request)
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, calendar)
+}
+
+// @Summary Get the task timeline
+// @Description Get the tasks matching the filters as bars from their start to their due date, with the blocked_by dependencies between them, for Gantt charts. Each task comes with its earliest and latest start and finish, its slack and whether it is critical, from the critical path method, and is at risk when due before it can finish given its blockers. Blockers outside of the selection do not constrain it. Employees only see tasks assigned to them.
+// @Tags tasks
+// @Produce json
+// @Param assignee query string false "Assignee ID"
+// @Param status query string false "Status" Enums("Pending", "In progress", "Blocked", "Completed")
+// @Param priority query string false "Priority" Enums("low", "medium", "high", "urgent")
+// @Param project query string false "Project ID"
+// @Param sprint query string false "Sprint ID"
+// @Param query query string false "Search query, as for GET /tasks"
+// @Success 200 {object} domain.TaskTimeline
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/timeline [get]
+func (h *handler) GetTaskTimeline(c *gin.Context) {
+	filter := map[string]string{}
+	queryFilter(c, filter)
+	timeline, err := h.svc.GetTaskTimeline(c.Request.Context(), c.GetString("role"), c.GetString("userId"), filter, c.Query("query"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, timeline)
+}
+
 // @Summary Update a task
 // @Description Update the details of a specific task
 // @Tags tasks
@@ -215,9 +474,9 @@ func (h *handler) UpdateTask(c *gin.Context) {
 	}
 
 	taskID := c.Param("taskID")
-	err := h.svc.UpdateTask(ctx, taskID, req.Name, req.Description)
+	err := h.svc.UpdateTask(ctx, taskID, req.ToDomain(), c.GetString("userId"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(errors.StatusCode(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -247,3 +506,66 @@ func (h *handler) DeleteTask(c *gin.Context) {
 	}
 	c.Status(http.StatusNoContent)
 }
+
+// MoveTask godoc
+// @Summary Move a task on the board
+// @Description Move a task to a status column between two neighboring tasks. Leave previous_task_id empty to move it to the top of the column and next_task_id empty to move it to the bottom. Employees can only move tasks assigned to them. Past a WIP limit of the column or of the assignee, only employers can move a task, with an override note kept for audit.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param move body dto.MoveTaskRequest true "Target status and neighbors"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 409 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/move [patch]
+func (h *handler) MoveTask(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var move dto.MoveTaskRequest
+	if err := c.ShouldBindJSON(&move); err != nil {
+		log.Errorf(ctx, "error binding move: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.MoveTask(ctx, c.Param("taskID"), move.ToDomain(), c.GetString("role"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task moved successfully"})
+}
+
+// SuggestAssignees godoc
+// @Summary Suggest assignees for a task
+// @Description Rank employees for a task by open tasks, overdue tasks and estimated hours, and by how many task labels match their skills. Higher scores come first.
+// @Tags tasks
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param limit query int false "Maximum number of suggestions (default 5)"
+// @Success 200 {array} domain.AssigneeSuggestion
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/assignee-suggestions [get]
+func (h *handler) SuggestAssignees(c *gin.Context) {
+	limit := 0
+	if l := c.Query("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 0 {
+			c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Limit must be a positive number"))
+			return
+		}
+	}
+
+	suggestions, err := h.svc.SuggestAssignees(c.Request.Context(), c.Param("taskID"), limit)
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, suggestions)
+}
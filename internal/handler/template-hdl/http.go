@@ -0,0 +1,26 @@
+package templatehdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	CreateTaskTemplate(c *gin.Context)
+	GetTaskTemplates(c *gin.Context)
+	GetTaskTemplate(c *gin.Context)
+	UpdateTaskTemplate(c *gin.Context)
+	DeleteTaskTemplate(c *gin.Context)
+	CreateTasksFromTemplate(c *gin.Context)
+}
+
+type handler struct {
+	svc port.TaskTemplateService
+}
+
+func New(svc port.TaskTemplateService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
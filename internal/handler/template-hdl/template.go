@@ -0,0 +1,165 @@
+package templatehdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create a task template
+// @Description Create a template for repeatable tasks. Title, description and checklist items may contain {{variable}} placeholders.
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param template body dto.CreateTaskTemplateRequest true "Template"
+// @Success 201 {object} domain.TaskTemplate
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /templates [post]
+func (h *handler) CreateTaskTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var template dto.CreateTaskTemplateRequest
+	if err := c.ShouldBindJSON(&template); err != nil {
+		log.Errorf(ctx, "error binding template: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.CreateTaskTemplate(ctx, template.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get task templates
+// @Description Get all task templates ordered by name
+// @Tags templates
+// @Produce json
+// @Success 200 {array} domain.TaskTemplate
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /templates [get]
+func (h *handler) GetTaskTemplates(c *gin.Context) {
+	templates, err := h.svc.GetTaskTemplates(c.Request.Context())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if templates == nil {
+		templates = []domain.TaskTemplate{}
+	}
+	c.JSON(http.StatusOK, templates)
+}
+
+// @Summary Get a task template
+// @Description Get a task template and the variables it uses
+// @Tags templates
+// @Produce json
+// @Param templateID path string true "Template ID"
+// @Success 200 {object} domain.TaskTemplate
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /templates/{templateID} [get]
+func (h *handler) GetTaskTemplate(c *gin.Context) {
+	template, err := h.svc.GetTaskTemplate(c.Request.Context(), c.Param("templateID"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, template)
+}
+
+// @Summary Update a task template
+// @Description Update a task template. Tasks already created from it are not changed.
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param templateID path string true "Template ID"
+// @Param template body dto.UpdateTaskTemplateRequest true "Template"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /templates/{templateID} [patch]
+func (h *handler) UpdateTaskTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var template dto.UpdateTaskTemplateRequest
+	if err := c.ShouldBindJSON(&template); err != nil {
+		log.Errorf(ctx, "error binding template: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateTaskTemplate(ctx, c.Param("templateID"), template.ToDomain()); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Template updated successfully"})
+}
+
+// @Summary Delete a task template
+// @Description Delete a task template. Tasks already created from it are kept.
+// @Tags templates
+// @Produce json
+// @Param templateID path string true "Template ID"
+// @Success 204
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /templates/{templateID} [delete]
+func (h *handler) DeleteTaskTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.svc.DeleteTaskTemplate(ctx, c.Param("templateID")); err != nil {
+		log.Errorf(ctx, "error deleting template: %v", err)
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary Create tasks from a template
+// @Description Create one task per entry of tasks, filling the template placeholders with its variables, in a single transaction. An empty body creates one task from the template defaults.
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param templateID path string true "Template ID"
+// @Param tasks body dto.CreateTasksFromTemplateRequest false "Tasks to create"
+// @Success 201 {array} domain.Task
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /templates/{templateID}/tasks [post]
+func (h *handler) CreateTasksFromTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.CreateTasksFromTemplateRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Errorf(ctx, "error binding template tasks: %v", err)
+			c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+			return
+		}
+	}
+
+	tasks, err := h.svc.CreateTasksFromTemplate(ctx, c.Param("templateID"), req.ToDomain(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, tasks)
+}
@@ -0,0 +1,25 @@
+package userhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	UpdateUserSkills(c *gin.Context)
+	UpdateMyTimezone(c *gin.Context)
+	UpdateMyEmail(c *gin.Context)
+	UpdateUserClaimLimit(c *gin.Context)
+	UpdateUserWIPLimit(c *gin.Context)
+}
+
+type handler struct {
+	svc port.UserService
+}
+
+func New(svc port.UserService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
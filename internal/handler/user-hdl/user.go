@@ -0,0 +1,158 @@
+package userhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Set the skills of an employee
+// @Description Replace the skills of an employee. Skills are matched against task labels when suggesting and auto-assigning tasks.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param userID path string true "User ID"
+// @Param skills body dto.UpdateUserSkillsRequest true "Skills"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/{userID}/skills [put]
+func (h *handler) UpdateUserSkills(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.UpdateUserSkillsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding skills: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateUserSkills(ctx, c.Param("userID"), req.Skills); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Skills updated successfully"})
+}
+
+// @Summary Set your timezone
+// @Description Set the IANA timezone your calendar and due date searches are read in. Defaults to UTC.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param timezone body dto.UpdateTimezoneRequest true "Timezone"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/timezone [put]
+func (h *handler) UpdateMyTimezone(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.UpdateTimezoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding timezone: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateUserTimezone(ctx, c.GetString("userId"), req.Timezone); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Timezone updated successfully"})
+}
+
+// @Summary Set your email address
+// @Description Set the address your notifications and daily digest are emailed to. A null or empty email stops all emails.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param email body dto.UpdateEmailRequest true "Email"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/email [put]
+func (h *handler) UpdateMyEmail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.UpdateEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding email: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateUserEmail(ctx, c.GetString("userId"), req.Email); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Email updated successfully"})
+}
+
+// @Summary Set the claim limit of an employee
+// @Description Set how many pool tasks an employee can hold at once, overriding POOL_CLAIM_LIMIT. A null limit restores the default, 0 removes the limit.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param userID path string true "User ID"
+// @Param limit body dto.UpdateClaimLimitRequest true "Claim limit"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/{userID}/claim-limit [put]
+func (h *handler) UpdateUserClaimLimit(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.UpdateClaimLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding claim limit: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateUserClaimLimit(ctx, c.Param("userID"), req.ClaimLimit); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Claim limit updated successfully"})
+}
+
+// @Summary Set the WIP limit of an employee
+// @Description Set how many tasks an employee can have In Progress at once, overriding WIP_LIMIT. A null limit restores the default, 0 removes the limit.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param userID path string true "User ID"
+// @Param limit body dto.UpdateWIPLimitRequest true "WIP limit"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/{userID}/wip-limit [put]
+func (h *handler) UpdateUserWIPLimit(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.UpdateWIPLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding WIP limit: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateUserWIPLimit(ctx, c.Param("userID"), req.WIPLimit); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "WIP limit updated successfully"})
+}
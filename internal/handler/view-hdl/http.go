@@ -0,0 +1,25 @@
+package viewhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	CreateTaskView(c *gin.Context)
+	GetTaskViews(c *gin.Context)
+	GetTaskView(c *gin.Context)
+	UpdateTaskView(c *gin.Context)
+	DeleteTaskView(c *gin.Context)
+}
+
+type handler struct {
+	svc port.TaskViewService
+}
+
+func New(svc port.TaskViewService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
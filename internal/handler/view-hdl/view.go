@@ -0,0 +1,134 @@
+package viewhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create a saved view
+// @Description Save a named combination of filters, query, sort and columns for the task list. Only employers can publish default views.
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param view body dto.CreateTaskViewRequest true "View"
+// @Success 201 {object} domain.TaskView
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /views [post]
+func (h *handler) CreateTaskView(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var view dto.CreateTaskViewRequest
+	if err := c.ShouldBindJSON(&view); err != nil {
+		log.Errorf(ctx, "error binding view: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	created, err := h.svc.CreateTaskView(ctx, view.ToDomain(), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// @Summary Get saved views
+// @Description Get the views owned by the user and the views shared with the organization, default views first
+// @Tags views
+// @Produce json
+// @Success 200 {array} domain.TaskView
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /views [get]
+func (h *handler) GetTaskViews(c *gin.Context) {
+	views, err := h.svc.GetTaskViews(c.Request.Context(), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if views == nil {
+		views = []domain.TaskView{}
+	}
+	c.JSON(http.StatusOK, views)
+}
+
+// @Summary Get a saved view
+// @Description Get a view owned by the user or shared with the organization
+// @Tags views
+// @Produce json
+// @Param viewID path string true "View ID"
+// @Success 200 {object} domain.TaskView
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /views/{viewID} [get]
+func (h *handler) GetTaskView(c *gin.Context) {
+	view, err := h.svc.GetTaskView(c.Request.Context(), c.Param("viewID"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, view)
+}
+
+// @Summary Update a saved view
+// @Description Update a view owned by the user
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param viewID path string true "View ID"
+// @Param view body dto.UpdateTaskViewRequest true "View"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /views/{viewID} [patch]
+func (h *handler) UpdateTaskView(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var view dto.UpdateTaskViewRequest
+	if err := c.ShouldBindJSON(&view); err != nil {
+		log.Errorf(ctx, "error binding view: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.UpdateTaskView(ctx, c.Param("viewID"), view.ToDomain(), c.GetString("role"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "View updated successfully"})
+}
+
+// @Summary Delete a saved view
+// @Description Delete a view owned by the user
+// @Tags views
+// @Produce json
+// @Param viewID path string true "View ID"
+// @Success 204
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /views/{viewID} [delete]
+func (h *handler) DeleteTaskView(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.svc.DeleteTaskView(ctx, c.Param("viewID"), c.GetString("userId")); err != nil {
+		log.Errorf(ctx, "error deleting view: %v", err)
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
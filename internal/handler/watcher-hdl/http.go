@@ -0,0 +1,24 @@
+package watcherhdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	WatchTask(c *gin.Context)
+	UnwatchTask(c *gin.Context)
+	GetTaskWatchers(c *gin.Context)
+	GetWatchedTasks(c *gin.Context)
+}
+
+type handler struct {
+	svc port.WatcherService
+}
+
+func New(svc port.WatcherService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
@@ -0,0 +1,101 @@
+package watcherhdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Watch a task
+// @Description Follow a task to be notified of its changes, or mute or unmute a task you watch. Creators and assignees watch their tasks automatically. Muted tasks send you no notification at all. Employees can only watch tasks assigned to them.
+// @Tags watchers
+// @Accept json
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Param watch body dto.WatchTaskRequest false "Mute"
+// @Success 200 {object} domain.TaskWatcher
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/watch [put]
+func (h *handler) WatchTask(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.WatchTaskRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Errorf(ctx, "error binding watch: %v", err)
+			c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+			return
+		}
+	}
+
+	watcher, err := h.svc.WatchTask(ctx, c.Param("taskID"), c.GetString("role"), c.GetString("userId"), req.ToDomain())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, watcher)
+}
+
+// @Summary Stop watching a task
+// @Description Stop following a task. Being assigned the task again makes you watch it again.
+// @Tags watchers
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/watch [delete]
+func (h *handler) UnwatchTask(c *gin.Context) {
+	if err := h.svc.UnwatchTask(c.Request.Context(), c.Param("taskID"), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "Task unwatched successfully"})
+}
+
+// @Summary Get the watchers of a task
+// @Description Get the watchers of a task. Employees can only get the watchers of tasks assigned to them.
+// @Tags watchers
+// @Produce json
+// @Param taskID path string true "Task ID"
+// @Success 200 {array} domain.TaskWatcher
+// @Failure 403 {object} errors.ErrorResponse
+// @Failure 404 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /tasks/{taskID}/watchers [get]
+func (h *handler) GetTaskWatchers(c *gin.Context) {
+	watchers, err := h.svc.GetTaskWatchers(c.Request.Context(), c.Param("taskID"), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, watchers)
+}
+
+// @Summary Get the tasks you watch
+// @Description Get the tasks you watch, most recently updated first. Employees only get the ones assigned to them.
+// @Tags watchers
+// @Produce json
+// @Success 200 {array} domain.Task
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /users/me/watching [get]
+func (h *handler) GetWatchedTasks(c *gin.Context) {
+	tasks, err := h.svc.GetWatchedTasks(c.Request.Context(), c.GetString("role"), c.GetString("userId"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, tasks)
+}
@@ -0,0 +1,23 @@
+package wiphdl
+
+import (
+	"kn-assignment/internal/core/port"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Handler interface {
+	GetWIPLimits(c *gin.Context)
+	SetStatusWIPLimit(c *gin.Context)
+	GetWIPOverrides(c *gin.Context)
+}
+
+type handler struct {
+	svc port.WIPService
+}
+
+func New(svc port.WIPService) Handler {
+	return &handler{
+		svc: svc,
+	}
+}
@@ -0,0 +1,79 @@
+package wiphdl
+
+import (
+	"net/http"
+
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/handler/dto"
+	"kn-assignment/internal/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get WIP limits
+// @Description Get the default WIP limit of employees, overridden by their own wip_limit, and the WIP limit and task count of every status column in board order
+// @Tags wip-limits
+// @Produce json
+// @Success 200 {object} domain.WIPLimits
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /wip-limits [get]
+func (h *handler) GetWIPLimits(c *gin.Context) {
+	limits, err := h.svc.GetWIPLimits(c.Request.Context())
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, limits)
+}
+
+// @Summary Set the WIP limit of a status column
+// @Description Set how many tasks a status column holds, or remove its limit with a null limit. Tasks already past a new limit stay where they are.
+// @Tags wip-limits
+// @Accept json
+// @Produce json
+// @Param limit body dto.SetStatusWIPLimitRequest true "WIP limit"
+// @Success 200 {object} dto.BaseResponse
+// @Failure 400 {object} errors.ErrorResponse
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /wip-limits [put]
+func (h *handler) SetStatusWIPLimit(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.SetStatusWIPLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Errorf(ctx, "error binding WIP limit: %v", err)
+		c.JSON(http.StatusBadRequest, errors.NewCustomErrorWithMessage(constant.ErrCodeInvalidRequest, "Invalid request payload"))
+		return
+	}
+
+	if err := h.svc.SetStatusWIPLimit(ctx, req.ToDomain(), c.GetString("userId")); err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.BaseResponse{Message: "WIP limit updated successfully"})
+}
+
+// @Summary Get WIP limit overrides
+// @Description Get the WIP limits employers went past, with their notes, the latest first
+// @Tags wip-limits
+// @Produce json
+// @Param task query string false "Task ID"
+// @Success 200 {array} domain.WIPOverride
+// @Failure 500 {object} errors.ErrorResponse
+// @Security BearerAuth
+// @Router /wip-limits/overrides [get]
+func (h *handler) GetWIPOverrides(c *gin.Context) {
+	overrides, err := h.svc.GetWIPOverrides(c.Request.Context(), c.Query("task"))
+	if err != nil {
+		c.JSON(errors.StatusCode(err), err)
+		return
+	}
+	if overrides == nil {
+		overrides = []domain.WIPOverride{}
+	}
+	c.JSON(http.StatusOK, overrides)
+}
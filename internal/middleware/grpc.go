@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/util"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type claimsCtxKey struct{}
+
+// MethodAccess describes who is allowed to call a gRPC method.
+// An empty Role only requires a valid access token.
+type MethodAccess struct {
+	Public bool
+	Role   domain.Role
+}
+
+// ClaimsFromContext returns the JWT claims stored by UnaryAuthInterceptor
+func ClaimsFromContext(ctx context.Context) (*util.Claims, bool) {
+	claims, ok := ctx.Value(claimsCtxKey{}).(*util.Claims)
+	return claims, ok
+}
+
+// UnaryAuthInterceptor is the gRPC counterpart of AuthMiddleware and RoleMiddleware.
+// Methods missing from access require a valid token but no particular role.
+func UnaryAuthInterceptor(access map[string]MethodAccess) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		rule := access[info.FullMethod]
+		if rule.Public {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		authHeader := md.Get("authorization")
+		if len(authHeader) == 0 || authHeader[0] == "" {
+			return nil, status.Error(codes.Unauthenticated, constant.ErrMsgUnauthorized)
+		}
+
+		tokenStr := strings.TrimPrefix(authHeader[0], "Bearer ")
+		claims, err := util.ValidateToken(tokenStr)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, constant.ErrMsgUnauthorized)
+		}
+
+		if rule.Role != "" && domain.Role(claims.Role) != rule.Role {
+			return nil, status.Error(codes.PermissionDenied, constant.ErrMsgForbidden)
+		}
+
+		return handler(context.WithValue(ctx, claimsCtxKey{}, claims), req)
+	}
+}
+
+func UnaryRequestLogger(ctx context.Context) grpc.UnaryServerInterceptor {
+	return func(reqCtx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		t := time.Now()
+
+		resp, err := handler(reqCtx, req)
+
+		latency := time.Since(t)
+		logFormatRequest := fmt.Sprintf("Request gRPC [%s]: -> Code: %s Total Time: %s", info.FullMethod, status.Code(err), latency)
+		LogJsonWithCtx(ctx, logFormatRequest, nil)
+		return resp, err
+	}
+}
@@ -0,0 +1,160 @@
+package availabilityrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+const selectTimeOff = `SELECT id, user_id, TO_CHAR(start_date, 'YYYY-MM-DD') AS start_date, TO_CHAR(end_date, 'YYYY-MM-DD') AS end_date,
+		reason, created_by, created_at
+	FROM time_off`
+
+const selectHolidays = `SELECT TO_CHAR(date, 'YYYY-MM-DD') AS date, name, created_by, created_at FROM holidays`
+
+// GetWorkingHours returns the working hours of a user, the default ones when they did not set theirs
+func (r *repository) GetWorkingHours(ctx context.Context, userID string) (domain.WorkingHours, error) {
+	query := `SELECT days, TO_CHAR(start_time, 'HH24:MI') AS start, TO_CHAR(end_time, 'HH24:MI') AS end
+		FROM user_working_hours WHERE user_id = $1`
+	var hours domain.WorkingHours
+	err := pgxscan.Get(ctx, r.dbPool, &hours, query, userID)
+	if pgxscan.NotFound(err) {
+		return domain.DefaultWorkingHours(), nil
+	}
+	if err != nil {
+		return domain.WorkingHours{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return hours, nil
+}
+
+func (r *repository) SetWorkingHours(ctx context.Context, userID string, hours domain.WorkingHours) error {
+	query := `INSERT INTO user_working_hours (user_id, days, start_time, end_time, updated_at)
+		VALUES ($1, $2, $3::TEXT::TIME, $4::TEXT::TIME, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET days = EXCLUDED.days, start_time = EXCLUDED.start_time, end_time = EXCLUDED.end_time, updated_at = NOW()`
+	_, err := r.dbPool.Exec(ctx, query, userID, hours.Days, hours.Start, hours.End)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) CreateTimeOff(ctx context.Context, userID string, timeOff domain.CreateTimeOffRequest, createdBy string) (domain.TimeOff, error) {
+	query := `INSERT INTO time_off (user_id, start_date, end_date, reason, created_by, created_at)
+		VALUES ($1, $2::TEXT::DATE, $3::TEXT::DATE, $4, $5, NOW()) RETURNING id`
+	var timeOffID string
+	err := r.dbPool.QueryRow(ctx, query, userID, timeOff.StartDate, timeOff.EndDate, timeOff.Reason, createdBy).Scan(&timeOffID)
+	if err != nil {
+		return domain.TimeOff{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return r.GetTimeOffByID(ctx, timeOffID)
+}
+
+func (r *repository) GetTimeOffByID(ctx context.Context, timeOffID string) (domain.TimeOff, error) {
+	query := selectTimeOff + ` WHERE id = $1`
+	var timeOff domain.TimeOff
+	err := pgxscan.Get(ctx, r.dbPool, &timeOff, query, timeOffID)
+	if pgxscan.NotFound(err) {
+		return domain.TimeOff{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Time off not found")
+	}
+	if err != nil {
+		return domain.TimeOff{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return timeOff, nil
+}
+
+// GetTimeOff returns the time off of a user overlapping two days, both included, the earliest first.
+// An empty day leaves the range open on its side.
+func (r *repository) GetTimeOff(ctx context.Context, userID, from, to string) ([]domain.TimeOff, error) {
+	query := selectTimeOff + ` WHERE user_id = $1 AND ($2 = '' OR end_date >= $2::DATE) AND ($3 = '' OR start_date <= $3::DATE)
+		ORDER BY start_date ASC`
+	var timeOff []domain.TimeOff
+	err := pgxscan.Select(ctx, r.dbPool, &timeOff, query, userID, from, to)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return timeOff, nil
+}
+
+// GetUsersOff returns the users taking off the day of any of the given times, in their timezone
+func (r *repository) GetUsersOff(ctx context.Context, at []time.Time) ([]string, error) {
+	query := `SELECT DISTINCT o.user_id::TEXT FROM time_off o
+		JOIN users u ON u.id = o.user_id
+		JOIN unnest($1::TIMESTAMPTZ[]) AS t(at) ON (t.at AT TIME ZONE u.timezone)::DATE BETWEEN o.start_date AND o.end_date`
+	var userIDs []string
+	err := pgxscan.Select(ctx, r.dbPool, &userIDs, query, at)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return userIDs, nil
+}
+
+func (r *repository) DeleteTimeOff(ctx context.Context, timeOffID string) error {
+	query := `DELETE FROM time_off WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, timeOffID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) CreateHoliday(ctx context.Context, holiday domain.CreateHolidayRequest, userID string) (domain.Holiday, error) {
+	query := `INSERT INTO holidays (date, name, created_by, created_at) VALUES ($1::TEXT::DATE, $2, $3, NOW())
+		ON CONFLICT (date) DO NOTHING
+		RETURNING TO_CHAR(date, 'YYYY-MM-DD') AS date, name, created_by, created_at`
+	var created domain.Holiday
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, holiday.Date, holiday.Name, userID)
+	if pgxscan.NotFound(err) {
+		return domain.Holiday{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "There is already a holiday on this day")
+	}
+	if err != nil {
+		return domain.Holiday{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+// GetHolidays returns the holidays between two days, both included, in order. An empty day
+// leaves the range open on its side.
+func (r *repository) GetHolidays(ctx context.Context, from, to string) ([]domain.Holiday, error) {
+	query := selectHolidays + ` WHERE ($1 = '' OR date >= $1::DATE) AND ($2 = '' OR date <= $2::DATE) ORDER BY date ASC`
+	var holidays []domain.Holiday
+	err := pgxscan.Select(ctx, r.dbPool, &holidays, query, from, to)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return holidays, nil
+}
+
+func (r *repository) DeleteHoliday(ctx context.Context, date string) error {
+	query := `DELETE FROM holidays WHERE date = $1::TEXT::DATE`
+	tag, err := r.dbPool.Exec(ctx, query, date)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Holiday not found")
+	}
+	return nil
+}
+
+// GetWorkCalendar returns the timezone, working hours, holidays and time off of a user from a day on
+func (r *repository) GetWorkCalendar(ctx context.Context, userID, from string) (domain.WorkCalendar, error) {
+	var cal domain.WorkCalendar
+	err := r.dbPool.QueryRow(ctx, `SELECT timezone FROM users WHERE id = $1`, userID).Scan(&cal.Timezone)
+	if err != nil {
+		return domain.WorkCalendar{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "User not found")
+	}
+	if cal.WorkingHours, err = r.GetWorkingHours(ctx, userID); err != nil {
+		return domain.WorkCalendar{}, err
+	}
+	if cal.Holidays, err = r.GetHolidays(ctx, from, ""); err != nil {
+		return domain.WorkCalendar{}, err
+	}
+	if cal.TimeOff, err = r.GetTimeOff(ctx, userID, from, ""); err != nil {
+		return domain.WorkCalendar{}, err
+	}
+	return cal, nil
+}
@@ -0,0 +1,196 @@
+package checklistrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/rank"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *repository) GetChecklistItems(ctx context.Context, taskID string) ([]domain.ChecklistItem, error) {
+	query := `SELECT * FROM checklist_items WHERE task_id = $1 ORDER BY position ASC, created_at ASC`
+	var items []domain.ChecklistItem
+	err := pgxscan.Select(ctx, r.dbPool, &items, query, taskID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return items, nil
+}
+
+func (r *repository) GetChecklistItemByID(ctx context.Context, itemID string) (domain.ChecklistItem, error) {
+	query := `SELECT * FROM checklist_items WHERE id = $1`
+	var item domain.ChecklistItem
+	err := pgxscan.Get(ctx, r.dbPool, &item, query, itemID)
+	if pgxscan.NotFound(err) {
+		return domain.ChecklistItem{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Checklist item not found")
+	}
+	if err != nil {
+		return domain.ChecklistItem{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return item, nil
+}
+
+func (r *repository) GetLastChecklistPosition(ctx context.Context, taskID string) (string, error) {
+	query := `SELECT COALESCE(MAX(position), '') FROM checklist_items WHERE task_id = $1`
+	var position string
+	err := r.dbPool.QueryRow(ctx, query, taskID).Scan(&position)
+	if err != nil {
+		return "", errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return position, nil
+}
+
+func (r *repository) CreateChecklistItem(ctx context.Context, taskID string, item domain.CreateChecklistItemRequest) (domain.ChecklistItem, error) {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return domain.ChecklistItem{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query := `INSERT INTO checklist_items (task_id, content, required, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW()) RETURNING *`
+	var created domain.ChecklistItem
+	if err := pgxscan.Get(ctx, tx, &created, query, taskID, item.Content, item.Required, item.Position); err != nil {
+		return domain.ChecklistItem{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if err := refreshProgress(ctx, tx, taskID); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.ChecklistItem{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) UpdateChecklistItem(ctx context.Context, itemID string, item domain.UpdateChecklistItemRequest) error {
+	ub := r.sqlbuilder.NewUpdateBuilder()
+	ub.Update("checklist_items")
+
+	if item.Content != nil {
+		ub.SetMore(ub.Assign("content", *item.Content))
+	}
+	if item.Required != nil {
+		ub.SetMore(ub.Assign("required", *item.Required))
+	}
+
+	ub.SetMore("updated_at = NOW()")
+	ub.Where(ub.Equal("id", itemID))
+
+	query, args := ub.Build()
+	_, err := r.dbPool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) SetChecklistItemDone(ctx context.Context, taskID, itemID string, done bool, userID string) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query := `UPDATE checklist_items
+		SET done = $1,
+			done_by = CASE WHEN $1 THEN $2::UUID END,
+			done_at = CASE WHEN $1 THEN NOW() END,
+			updated_at = NOW()
+		WHERE id = $3`
+	if _, err := tx.Exec(ctx, query, done, userID, itemID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if err := refreshProgress(ctx, tx, taskID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) MoveChecklistItem(ctx context.Context, itemID, position string) error {
+	query := `UPDATE checklist_items SET position = $1, updated_at = NOW() WHERE id = $2`
+	_, err := r.dbPool.Exec(ctx, query, position, itemID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// RebalanceChecklist spreads the positions of a checklist evenly, keeping the current order
+func (r *repository) RebalanceChecklist(ctx context.Context, taskID string) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query := `SELECT id FROM checklist_items WHERE task_id = $1 ORDER BY position ASC, created_at ASC FOR UPDATE`
+	var itemIDs []string
+	if err := pgxscan.Select(ctx, tx, &itemIDs, query, taskID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	batch := &pgx.Batch{}
+	for i, position := range rank.Spread(len(itemIDs)) {
+		batch.Queue(`UPDATE checklist_items SET position = $1 WHERE id = $2`, position, itemIDs[i])
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) DeleteChecklistItem(ctx context.Context, taskID, itemID string) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query := `DELETE FROM checklist_items WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, itemID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if err := refreshProgress(ctx, tx, taskID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) CountOpenRequiredItems(ctx context.Context, taskID string) (int, error) {
+	query := `SELECT COUNT(*) FROM checklist_items WHERE task_id = $1 AND required AND NOT done`
+	var count int
+	err := r.dbPool.QueryRow(ctx, query, taskID).Scan(&count)
+	if err != nil {
+		return 0, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return count, nil
+}
+
+// refreshProgress recounts the checklist progress stored on the task
+func refreshProgress(ctx context.Context, tx pgx.Tx, taskID string) error {
+	query := `UPDATE tasks SET
+			checklist_total = (SELECT COUNT(*) FROM checklist_items WHERE task_id = $1),
+			checklist_done = (SELECT COUNT(*) FROM checklist_items WHERE task_id = $1 AND done)
+		WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, taskID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package commentrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (r *repository) CreateTaskComment(ctx context.Context, taskID, body, authorID string) (domain.TaskComment, error) {
+	query := `WITH comment AS (
+			INSERT INTO task_comments (task_id, author_id, body, created_at, updated_at) VALUES ($1, $2, $3, NOW(), NOW())
+			RETURNING *
+		)
+		SELECT c.*, u.username FROM comment c JOIN users u ON u.id = c.author_id`
+	var created domain.TaskComment
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, taskID, authorID, body)
+	if err != nil {
+		return domain.TaskComment{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) GetTaskCommentByID(ctx context.Context, commentID string) (domain.TaskComment, error) {
+	query := `SELECT c.*, u.username FROM task_comments c JOIN users u ON u.id = c.author_id WHERE c.id = $1`
+	var comment domain.TaskComment
+	err := pgxscan.Get(ctx, r.dbPool, &comment, query, commentID)
+	if pgxscan.NotFound(err) {
+		return domain.TaskComment{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Comment not found")
+	}
+	if err != nil {
+		return domain.TaskComment{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return comment, nil
+}
+
+// GetTaskComments returns the comments of a task, oldest first
+func (r *repository) GetTaskComments(ctx context.Context, taskID string) ([]domain.TaskComment, error) {
+	query := `SELECT c.*, u.username FROM task_comments c JOIN users u ON u.id = c.author_id
+		WHERE c.task_id = $1 ORDER BY c.created_at ASC`
+	var comments []domain.TaskComment
+	err := pgxscan.Select(ctx, r.dbPool, &comments, query, taskID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return comments, nil
+}
+
+func (r *repository) UpdateTaskComment(ctx context.Context, commentID, body string) (domain.TaskComment, error) {
+	query := `WITH comment AS (
+			UPDATE task_comments SET body = $2, updated_at = NOW() WHERE id = $1
+			RETURNING *
+		)
+		SELECT c.*, u.username FROM comment c JOIN users u ON u.id = c.author_id`
+	var updated domain.TaskComment
+	err := pgxscan.Get(ctx, r.dbPool, &updated, query, commentID, body)
+	if pgxscan.NotFound(err) {
+		return domain.TaskComment{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Comment not found")
+	}
+	if err != nil {
+		return domain.TaskComment{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return updated, nil
+}
+
+func (r *repository) DeleteTaskComment(ctx context.Context, commentID string) error {
+	query := `DELETE FROM task_comments WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, commentID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
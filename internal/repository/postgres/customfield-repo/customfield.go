@@ -0,0 +1,98 @@
+package customfieldrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (r *repository) CreateCustomField(ctx context.Context, field domain.CreateCustomFieldRequest) (domain.CustomField, error) {
+	query := `INSERT INTO custom_fields (key, name, type, options, required, project_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW()) RETURNING *`
+	var created domain.CustomField
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, field.Key, field.Name, field.Type, field.Options, field.Required, field.ProjectID)
+	if err != nil {
+		return domain.CustomField{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) GetCustomFieldByID(ctx context.Context, fieldID string) (domain.CustomField, error) {
+	return r.getCustomField(ctx, `SELECT * FROM custom_fields WHERE id = $1`, fieldID)
+}
+
+func (r *repository) GetCustomFieldByKey(ctx context.Context, key string) (domain.CustomField, error) {
+	return r.getCustomField(ctx, `SELECT * FROM custom_fields WHERE key = $1`, key)
+}
+
+func (r *repository) getCustomField(ctx context.Context, query string, args ...any) (domain.CustomField, error) {
+	var field domain.CustomField
+	err := pgxscan.Get(ctx, r.dbPool, &field, query, args...)
+	if pgxscan.NotFound(err) {
+		return domain.CustomField{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Custom field not found")
+	}
+	if err != nil {
+		return domain.CustomField{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return field, nil
+}
+
+// GetCustomFields returns organization fields first, then fields of each project
+func (r *repository) GetCustomFields(ctx context.Context) ([]domain.CustomField, error) {
+	query := `SELECT * FROM custom_fields ORDER BY project_id NULLS FIRST, key ASC`
+	var fields []domain.CustomField
+	err := pgxscan.Select(ctx, r.dbPool, &fields, query)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return fields, nil
+}
+
+func (r *repository) UpdateCustomField(ctx context.Context, fieldID string, field domain.UpdateCustomFieldRequest) error {
+	ub := r.sqlbuilder.NewUpdateBuilder()
+	ub.Update("custom_fields")
+
+	if field.Name != nil {
+		ub.SetMore(ub.Assign("name", *field.Name))
+	}
+	if field.Options != nil {
+		ub.SetMore(ub.Assign("options", *field.Options))
+	}
+	if field.Required != nil {
+		ub.SetMore(ub.Assign("required", *field.Required))
+	}
+
+	ub.SetMore("updated_at = NOW()")
+	ub.Where(ub.Equal("id", fieldID))
+
+	query, args := ub.Build()
+	_, err := r.dbPool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// DeleteCustomField deletes the definition and the values stored on tasks
+func (r *repository) DeleteCustomField(ctx context.Context, field domain.CustomField) error {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if _, err := tx.Exec(ctx, `UPDATE tasks SET custom_fields = custom_fields - $1::TEXT WHERE custom_fields ? $1`, field.Key); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM custom_fields WHERE id = $1`, field.ID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
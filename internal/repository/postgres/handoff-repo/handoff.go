@@ -0,0 +1,97 @@
+package handoffrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/repository/postgres/pgtx"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (r *repository) CreateHandoffRequest(ctx context.Context, taskID string, request domain.CreateHandoffRequest, requestedBy string) (domain.HandoffRequest, error) {
+	query := `INSERT INTO handoff_requests (task_id, type, reason, suggested_assignee_id, requested_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW()) RETURNING *`
+	var created domain.HandoffRequest
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, taskID, request.Type, request.Reason, request.SuggestedAssigneeID, requestedBy)
+	if err != nil {
+		return domain.HandoffRequest{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) GetHandoffRequestByID(ctx context.Context, requestID string) (domain.HandoffRequest, error) {
+	query := `SELECT h.*, t.title AS task_title FROM handoff_requests h JOIN tasks t ON t.id = h.task_id WHERE h.id = $1`
+	var request domain.HandoffRequest
+	err := pgxscan.Get(ctx, r.dbPool, &request, query, requestID)
+	if pgxscan.NotFound(err) {
+		return domain.HandoffRequest{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Handoff request not found")
+	}
+	if err != nil {
+		return domain.HandoffRequest{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return request, nil
+}
+
+// GetTaskHandoffRequests returns the handoff history of a task, latest first
+func (r *repository) GetTaskHandoffRequests(ctx context.Context, taskID string) ([]domain.HandoffRequest, error) {
+	query := `SELECT h.*, t.title AS task_title FROM handoff_requests h JOIN tasks t ON t.id = h.task_id
+		WHERE h.task_id = $1 ORDER BY h.created_at DESC`
+	var requests []domain.HandoffRequest
+	err := pgxscan.Select(ctx, r.dbPool, &requests, query, taskID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return requests, nil
+}
+
+// GetHandoffRequests returns the requests with a status, oldest first so that the queue is worked in order
+func (r *repository) GetHandoffRequests(ctx context.Context, status domain.HandoffStatus) ([]domain.HandoffRequest, error) {
+	query := `SELECT h.*, t.title AS task_title FROM handoff_requests h JOIN tasks t ON t.id = h.task_id
+		WHERE h.status = $1 ORDER BY h.created_at ASC`
+	var requests []domain.HandoffRequest
+	err := pgxscan.Select(ctx, r.dbPool, &requests, query, status)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return requests, nil
+}
+
+// ResolveHandoffRequest resolves a pending request. Approving it hands the task over to
+// assigneeID, or leaves it unassigned when nil, and keeps the reason on the task.
+func (r *repository) ResolveHandoffRequest(ctx context.Context, request domain.HandoffRequest, status domain.HandoffStatus, note string,
+	assigneeID *string, resolvedBy string) error {
+	tx, err := pgtx.From(ctx, r.dbPool).Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query := `UPDATE handoff_requests SET status = $1, resolution_note = NULLIF($2, ''), resolved_by = $3, resolved_at = NOW(), updated_at = NOW()
+		WHERE id = $4 AND status = 'pending'`
+	tag, err := tx.Exec(ctx, query, status, note, resolvedBy, request.ID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Handoff request is already resolved")
+	}
+
+	if status == domain.HandoffApproved {
+		taskQuery := `UPDATE tasks SET assignee_id = $1, claimed_at = NULL, handoff_note = $2, updated_by = $3, updated_at = NOW() WHERE id = $4`
+		if _, err := tx.Exec(ctx, taskQuery, assigneeID, request.Reason, resolvedBy, request.TaskID); err != nil {
+			return errors.NewCustomError(constant.ErrCodeInternalServer)
+		}
+		if assigneeID != nil {
+			if _, err := tx.Exec(ctx, `UPDATE users SET last_assigned_at = NOW() WHERE id = $1`, *assigneeID); err != nil {
+				return errors.NewCustomError(constant.ErrCodeInternalServer)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
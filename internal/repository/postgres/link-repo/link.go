@@ -0,0 +1,145 @@
+package linkrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/repository/postgres/pgtx"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// pairConflict matches the unique index linking two tasks at most once per type
+const pairConflict = `ON CONFLICT (LEAST(source_task_id, target_task_id), GREATEST(source_task_id, target_task_id), type) DO NOTHING`
+
+func (r *repository) CreateTaskLink(ctx context.Context, sourceTaskID string, link domain.CreateTaskLinkRequest, userID string) (domain.TaskLink, error) {
+	query := `INSERT INTO task_links (source_task_id, target_task_id, type, created_by, created_at)
+		VALUES ($1, $2, $3, $4, NOW()) ` + pairConflict + ` RETURNING *`
+	var created domain.TaskLink
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, sourceTaskID, link.TargetTaskID, link.Type, userID)
+	if pgxscan.NotFound(err) {
+		return domain.TaskLink{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "The tasks are already linked with this type")
+	}
+	if err != nil {
+		return domain.TaskLink{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) GetTaskLinkByID(ctx context.Context, linkID string) (domain.TaskLink, error) {
+	query := `SELECT * FROM task_links WHERE id = $1`
+	var link domain.TaskLink
+	err := pgxscan.Get(ctx, r.dbPool, &link, query, linkID)
+	if pgxscan.NotFound(err) {
+		return domain.TaskLink{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Task link not found")
+	}
+	if err != nil {
+		return domain.TaskLink{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return link, nil
+}
+
+// GetTaskLinks returns the links of the given tasks, each seen from the task it was found for
+func (r *repository) GetTaskLinks(ctx context.Context, taskIDs []string) ([]domain.LinkedTask, error) {
+	query := `SELECT f.id AS from_task_id, l.id AS link_id, l.type, l.source_task_id = f.id AS outgoing,
+			t.id AS task_id, t.title, t.status, l.created_at, t.assignee_id
+		FROM unnest($1::UUID[]) AS f(id)
+		JOIN task_links l ON f.id IN (l.source_task_id, l.target_task_id)
+		JOIN tasks t ON t.id = CASE WHEN l.source_task_id = f.id THEN l.target_task_id ELSE l.source_task_id END
+		ORDER BY l.created_at ASC`
+	var links []domain.LinkedTask
+	err := pgxscan.Select(ctx, r.dbPool, &links, query, taskIDs)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return links, nil
+}
+
+func (r *repository) DeleteTaskLink(ctx context.Context, linkID string) error {
+	query := `DELETE FROM task_links WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, linkID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// CreateReferenceLinks links a task as relating to the existing tasks it references by ID or
+// key, other than itself, that it is not linked to with any type yet
+func (r *repository) CreateReferenceLinks(ctx context.Context, sourceTaskID string, targets []string, userID string) error {
+	var taskIDs, keys []string
+	for _, target := range targets {
+		if domain.IsTaskKey(target) {
+			keys = append(keys, target)
+		} else {
+			taskIDs = append(taskIDs, target)
+		}
+	}
+	query := `INSERT INTO task_links (source_task_id, target_task_id, type, created_by, created_at)
+		SELECT $1, t.id, $3, $4, NOW()
+		FROM tasks t
+		WHERE (t.id = ANY($2::UUID[]) OR t.id IN (SELECT task_id FROM task_keys WHERE key = ANY($5::TEXT[])))
+			AND t.id <> $1 AND NOT EXISTS (
+			SELECT 1 FROM task_links l
+			WHERE (l.source_task_id = $1 AND l.target_task_id = t.id) OR (l.source_task_id = t.id AND l.target_task_id = $1))
+		` + pairConflict
+	_, err := r.dbPool.Exec(ctx, query, sourceTaskID, taskIDs, domain.LinkRelatesTo, userID, keys)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// CloseAsDuplicate completes a task and links it as a duplicate of the original task
+func (r *repository) CloseAsDuplicate(ctx context.Context, taskID, originalTaskID, userID string) error {
+	tx, err := pgtx.From(ctx, r.dbPool).Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	linkQuery := `INSERT INTO task_links (source_task_id, target_task_id, type, created_by, created_at)
+		VALUES ($1, $2, $3, $4, NOW()) ` + pairConflict
+	if _, err := tx.Exec(ctx, linkQuery, taskID, originalTaskID, domain.LinkDuplicateOf, userID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	taskQuery := `UPDATE tasks SET status = $1, updated_by = $2, updated_at = NOW() WHERE id = $3`
+	if _, err := tx.Exec(ctx, taskQuery, domain.StatusCompleted, userID, taskID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// IsBlockedBy reports whether a task is blocked by another one, directly or through its blockers
+func (r *repository) IsBlockedBy(ctx context.Context, taskID, blockerID string) (bool, error) {
+	query := `WITH RECURSIVE blockers AS (
+			SELECT target_task_id AS task_id FROM task_links WHERE source_task_id = $1 AND type = $3
+			UNION
+			SELECT l.target_task_id FROM task_links l JOIN blockers b ON l.source_task_id = b.task_id WHERE l.type = $3
+		)
+		SELECT EXISTS (SELECT 1 FROM blockers WHERE task_id = $2)`
+	var blocked bool
+	err := r.dbPool.QueryRow(ctx, query, taskID, blockerID, domain.LinkBlockedBy).Scan(&blocked)
+	if err != nil {
+		return false, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return blocked, nil
+}
+
+// GetBlockingLinks returns the blocked_by links between the given tasks
+func (r *repository) GetBlockingLinks(ctx context.Context, taskIDs []string) ([]domain.TaskLink, error) {
+	query := `SELECT * FROM task_links WHERE type = $2 AND source_task_id = ANY($1::UUID[]) AND target_task_id = ANY($1::UUID[])
+		ORDER BY created_at ASC`
+	var links []domain.TaskLink
+	err := pgxscan.Select(ctx, r.dbPool, &links, query, taskIDs, domain.LinkBlockedBy)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return links, nil
+}
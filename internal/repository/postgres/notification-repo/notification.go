@@ -0,0 +1,258 @@
+package notificationrepo
+
+import (
+	"context"
+	"fmt"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// deliverNotifications inserts the notifications selected by a query as (user_id, type, task_id, actor_id, message)
+// through the channels their users prefer, leaving out the ones no channel is wanted for and the ones about
+// tasks their users muted. $1 holds the types emailed to users without a preference, and notifications are
+// only emailed to users with an email address.
+const deliverNotifications = `INSERT INTO notifications (user_id, type, task_id, actor_id, message, in_app, email_status, created_at)
+	SELECT n.user_id, n.type, n.task_id, n.actor_id, n.message, c.in_app, CASE WHEN c.email THEN 'pending' END, NOW()
+	FROM (%s) n
+	JOIN users u ON u.id = n.user_id
+	LEFT JOIN notification_preferences p ON p.user_id = n.user_id AND p.type = n.type
+	LEFT JOIN task_watchers m ON m.task_id = n.task_id AND m.user_id = n.user_id
+	CROSS JOIN LATERAL (
+		SELECT COALESCE(p.in_app, TRUE) AS in_app, COALESCE(p.email, n.type = ANY($1::TEXT[])) AND u.email IS NOT NULL AS email
+	) c
+	WHERE (c.in_app OR c.email) AND NOT COALESCE(m.muted, FALSE)`
+
+func emailedByDefault() []string {
+	types := make([]string, 0, len(domain.EmailedByDefault))
+	for _, t := range domain.EmailedByDefault {
+		types = append(types, string(t))
+	}
+	return types
+}
+
+// CreateNotifications creates the notifications of an event, sending the ones for watchers to every watcher of
+// their task. Each user gets the first notification naming them per task, and none about their own changes.
+func (r *repository) CreateNotifications(ctx context.Context, notifications []domain.CreateNotificationRequest) error {
+	userIDs := make([]string, 0, len(notifications))
+	watchers := make([]bool, 0, len(notifications))
+	types := make([]string, 0, len(notifications))
+	taskIDs := make([]*string, 0, len(notifications))
+	actorIDs := make([]*string, 0, len(notifications))
+	messages := make([]string, 0, len(notifications))
+	for _, n := range notifications {
+		userIDs = append(userIDs, n.UserID)
+		watchers = append(watchers, n.Watchers)
+		types = append(types, string(n.Type))
+		taskIDs = append(taskIDs, n.TaskID)
+		actorIDs = append(actorIDs, n.ActorID)
+		messages = append(messages, n.Message)
+	}
+	query := fmt.Sprintf(deliverNotifications, `SELECT DISTINCT ON (r.user_id, n.task_id) r.user_id, n.type, n.task_id::UUID, n.actor_id::UUID, n.message
+		FROM unnest($2::TEXT[], $3::BOOLEAN[], $4::TEXT[], $5::TEXT[], $6::TEXT[], $7::TEXT[])
+			WITH ORDINALITY AS n(user_id, watchers, type, task_id, actor_id, message, i)
+		CROSS JOIN LATERAL (
+			SELECT NULLIF(n.user_id, '')::UUID AS user_id WHERE NOT n.watchers
+			UNION ALL
+			SELECT w.user_id FROM task_watchers w WHERE n.watchers AND w.task_id = n.task_id::UUID
+		) r
+		WHERE r.user_id IS NOT NULL AND (n.actor_id IS NULL OR r.user_id <> n.actor_id::UUID)
+		ORDER BY r.user_id, n.task_id, n.i`)
+	_, err := r.dbPool.Exec(ctx, query, emailedByDefault(), userIDs, watchers, types, taskIDs, actorIDs, messages)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// GetNotifications returns the latest notifications of a user
+func (r *repository) GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]domain.Notification, error) {
+	query := `SELECT * FROM notifications WHERE user_id = $1 AND in_app AND (NOT $2 OR read_at IS NULL) ORDER BY created_at DESC LIMIT $3`
+	var notifications []domain.Notification
+	err := pgxscan.Select(ctx, r.dbPool, &notifications, query, userID, unreadOnly, limit)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return notifications, nil
+}
+
+func (r *repository) CountUnreadNotifications(ctx context.Context, userID string) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND in_app AND read_at IS NULL`
+	var count int
+	err := pgxscan.Get(ctx, r.dbPool, &count, query, userID)
+	if err != nil {
+		return 0, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return count, nil
+}
+
+// MarkNotificationRead marks a notification of the user read, keeping the time it was first read
+func (r *repository) MarkNotificationRead(ctx context.Context, notificationID, userID string) error {
+	query := `UPDATE notifications SET read_at = COALESCE(read_at, NOW()) WHERE id = $1 AND user_id = $2 AND in_app`
+	tag, err := r.dbPool.Exec(ctx, query, notificationID, userID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Notification not found")
+	}
+	return nil
+}
+
+func (r *repository) MarkAllNotificationsRead(ctx context.Context, userID string) (int64, error) {
+	query := `UPDATE notifications SET read_at = NOW() WHERE user_id = $1 AND in_app AND read_at IS NULL`
+	tag, err := r.dbPool.Exec(ctx, query, userID)
+	if err != nil {
+		return 0, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// CreateDueSoonNotifications notifies the assignees of open tasks due within the given time,
+// once per task and due date
+func (r *repository) CreateDueSoonNotifications(ctx context.Context, within time.Duration) (int64, error) {
+	query := fmt.Sprintf(deliverNotifications, `SELECT t.assignee_id AS user_id, $2::TEXT AS type, t.id AS task_id, NULL::UUID AS actor_id,
+			format('%s is due soon', to_json(t.title)) AS message
+		FROM tasks t
+		WHERE t.assignee_id IS NOT NULL AND t.status <> $3
+			AND t.due_date > NOW() AND t.due_date <= NOW() + make_interval(secs => $4)
+			AND NOT EXISTS (
+				SELECT 1 FROM notifications n
+				WHERE n.task_id = t.id AND n.user_id = t.assignee_id AND n.type = $2
+					AND n.created_at >= t.due_date - make_interval(secs => $4))`)
+	tag, err := r.dbPool.Exec(ctx, query, emailedByDefault(), domain.NotificationDueSoon, domain.StatusCompleted, within.Seconds())
+	if err != nil {
+		return 0, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// CreateOverdueNotifications notifies the assignees and watchers of open tasks that became overdue within
+// the given time, once per task and due date
+func (r *repository) CreateOverdueNotifications(ctx context.Context, within time.Duration) (int64, error) {
+	query := fmt.Sprintf(deliverNotifications, `SELECT r.user_id, $2::TEXT AS type, t.id AS task_id, NULL::UUID AS actor_id,
+			format('%s is overdue', to_json(t.title)) AS message
+		FROM tasks t
+		CROSS JOIN LATERAL (
+			SELECT t.assignee_id AS user_id WHERE t.assignee_id IS NOT NULL
+			UNION
+			SELECT w.user_id FROM task_watchers w WHERE w.task_id = t.id
+		) r
+		WHERE t.status <> $3
+			AND t.due_date <= NOW() AND t.due_date > NOW() - make_interval(secs => $4)
+			AND NOT EXISTS (
+				SELECT 1 FROM notifications n
+				WHERE n.task_id = t.id AND n.type = $2 AND n.created_at >= t.due_date)`)
+	tag, err := r.dbPool.Exec(ctx, query, emailedByDefault(), domain.NotificationOverdue, domain.StatusCompleted, within.Seconds())
+	if err != nil {
+		return 0, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *repository) DeleteNotificationsBefore(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM notifications WHERE created_at < $1`
+	tag, err := r.dbPool.Exec(ctx, query, before)
+	if err != nil {
+		return 0, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetNotificationPreferences returns the preferences a user has set, types left to their default are missing
+func (r *repository) GetNotificationPreferences(ctx context.Context, userID string) ([]domain.NotificationPreference, error) {
+	query := `SELECT type, in_app, email FROM notification_preferences WHERE user_id = $1`
+	var preferences []domain.NotificationPreference
+	err := pgxscan.Select(ctx, r.dbPool, &preferences, query, userID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return preferences, nil
+}
+
+func (r *repository) UpsertNotificationPreferences(ctx context.Context, userID string, preferences []domain.NotificationPreference) error {
+	types := make([]string, 0, len(preferences))
+	inApp := make([]bool, 0, len(preferences))
+	email := make([]bool, 0, len(preferences))
+	for _, preference := range preferences {
+		types = append(types, string(preference.Type))
+		inApp = append(inApp, preference.InApp)
+		email = append(email, preference.Email)
+	}
+	query := `INSERT INTO notification_preferences (user_id, type, in_app, email, updated_at)
+		SELECT $1, p.type, p.in_app, p.email, NOW()
+		FROM unnest($2::TEXT[], $3::BOOLEAN[], $4::BOOLEAN[]) AS p(type, in_app, email)
+		ON CONFLICT (user_id, type) DO UPDATE SET in_app = EXCLUDED.in_app, email = EXCLUDED.email, updated_at = NOW()`
+	_, err := r.dbPool.Exec(ctx, query, userID, types, inApp, email)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// GetPendingEmails returns the oldest notifications created since the given time waiting to be emailed
+func (r *repository) GetPendingEmails(ctx context.Context, since time.Time, limit int) ([]domain.NotificationEmail, error) {
+	query := `SELECT n.*, u.email, u.username, t.title AS task_title
+		FROM notifications n
+		JOIN users u ON u.id = n.user_id
+		LEFT JOIN tasks t ON t.id = n.task_id
+		WHERE n.email_status = $1 AND n.created_at >= $2 AND u.email IS NOT NULL
+		ORDER BY n.created_at ASC
+		LIMIT $3`
+	var emails []domain.NotificationEmail
+	err := pgxscan.Select(ctx, r.dbPool, &emails, query, domain.EmailPending, since, limit)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return emails, nil
+}
+
+func (r *repository) MarkEmailSent(ctx context.Context, notificationID string) error {
+	query := `UPDATE notifications SET email_status = $2, email_attempts = email_attempts + 1, emailed_at = NOW() WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, notificationID, domain.EmailSent)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// MarkEmailFailed counts a failed attempt to email a notification, giving up after maxAttempts
+func (r *repository) MarkEmailFailed(ctx context.Context, notificationID string, maxAttempts int) error {
+	query := `UPDATE notifications SET email_attempts = email_attempts + 1,
+			email_status = CASE WHEN email_attempts + 1 >= $2 THEN $3 ELSE email_status END
+		WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, notificationID, maxAttempts, domain.EmailFailed)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// GetDigestRecipients returns the employees wanting a digest who have not had one today, in their timezone,
+// once it is past the given hour there
+func (r *repository) GetDigestRecipients(ctx context.Context, hour int) ([]domain.User, error) {
+	query := `SELECT u.* FROM users u
+		LEFT JOIN notification_preferences p ON p.user_id = u.id AND p.type = $1
+		WHERE u.role = $2 AND u.email IS NOT NULL AND COALESCE(p.email, $3)
+			AND EXTRACT(HOUR FROM NOW() AT TIME ZONE u.timezone) >= $4
+			AND (u.digest_sent_on IS NULL OR u.digest_sent_on < (NOW() AT TIME ZONE u.timezone)::DATE)`
+	var users []domain.User
+	err := pgxscan.Select(ctx, r.dbPool, &users, query, domain.NotificationDigest, domain.RoleEmployee,
+		domain.DefaultNotificationPreference(domain.NotificationDigest).Email, hour)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return users, nil
+}
+
+func (r *repository) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	query := `UPDATE users SET digest_sent_on = $2 WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, userID, day)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
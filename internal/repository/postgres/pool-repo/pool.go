@@ -0,0 +1,89 @@
+package poolrepo
+
+import (
+	"context"
+	"fmt"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/repository/postgres/pgtx"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetPoolTasks returns the open tasks of the pool, most urgent and oldest first
+func (r *repository) GetPoolTasks(ctx context.Context) ([]domain.Task, error) {
+	query := `SELECT * FROM tasks WHERE pooled AND assignee_id IS NULL AND status <> $1 ORDER BY priority DESC, created_at ASC`
+	var tasks []domain.Task
+	err := pgxscan.Select(ctx, r.dbPool, &tasks, query, domain.StatusCompleted)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tasks, nil
+}
+
+func (r *repository) SetTaskPooled(ctx context.Context, taskID string, pooled bool, userID string) error {
+	query := `UPDATE tasks SET pooled = $1, updated_by = $2, updated_at = NOW() WHERE id = $3`
+	_, err := r.dbPool.Exec(ctx, query, pooled, userID, taskID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// ClaimTask assigns an open pool task to the employee. Claims of an employee are serialized
+// on their user row so that they cannot exceed the limit, 0 being no limit, and the first
+// claim of a task wins.
+func (r *repository) ClaimTask(ctx context.Context, taskID, userID string, limit int) error {
+	tx, err := pgtx.From(ctx, r.dbPool).Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if _, err := tx.Exec(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if limit > 0 {
+		var claimed int
+		countQuery := `SELECT COUNT(*) FROM tasks WHERE assignee_id = $1 AND claimed_at IS NOT NULL AND status <> $2`
+		if err := pgxscan.Get(ctx, tx, &claimed, countQuery, userID, domain.StatusCompleted); err != nil {
+			return errors.NewCustomError(constant.ErrCodeInternalServer)
+		}
+		if claimed >= limit {
+			return errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, fmt.Sprintf("You already hold %d claimed tasks, the most you can claim", claimed))
+		}
+	}
+
+	claimQuery := `UPDATE tasks SET assignee_id = $1, claimed_at = NOW(), updated_by = $1, updated_at = NOW()
+		WHERE id = $2 AND pooled AND assignee_id IS NULL AND status <> $3`
+	tag, err := tx.Exec(ctx, claimQuery, userID, taskID, domain.StatusCompleted)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Task is no longer open in the pool")
+	}
+	if _, err := tx.Exec(ctx, `UPDATE users SET last_assigned_at = NOW() WHERE id = $1`, userID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// ReleaseTask gives a claimed task back to the pool
+func (r *repository) ReleaseTask(ctx context.Context, taskID, userID string) error {
+	query := `UPDATE tasks SET assignee_id = NULL, claimed_at = NULL, updated_by = $1, updated_at = NOW()
+		WHERE id = $2 AND pooled AND assignee_id = $1 AND claimed_at IS NOT NULL AND status <> $3`
+	tag, err := r.dbPool.Exec(ctx, query, userID, taskID, domain.StatusCompleted)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "You can only release open tasks you claimed from the pool")
+	}
+	return nil
+}
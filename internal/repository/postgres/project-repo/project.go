@@ -0,0 +1,72 @@
+package projectrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (r *repository) CreateProject(ctx context.Context, project domain.CreateProjectRequest) (domain.Project, error) {
+	query := `INSERT INTO projects (key, name, review_required, created_at, updated_at) VALUES ($1, $2, $3, NOW(), NOW()) RETURNING *`
+	var created domain.Project
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, project.Key, project.Name, project.ReviewRequired)
+	if err != nil {
+		return domain.Project{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) GetProjectByID(ctx context.Context, projectID string) (domain.Project, error) {
+	return r.getProject(ctx, `SELECT * FROM projects WHERE id = $1`, projectID)
+}
+
+func (r *repository) GetProjectByKey(ctx context.Context, key string) (domain.Project, error) {
+	return r.getProject(ctx, `SELECT * FROM projects WHERE key = $1`, key)
+}
+
+func (r *repository) getProject(ctx context.Context, query string, args ...any) (domain.Project, error) {
+	var project domain.Project
+	err := pgxscan.Get(ctx, r.dbPool, &project, query, args...)
+	if pgxscan.NotFound(err) {
+		return domain.Project{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Project not found")
+	}
+	if err != nil {
+		return domain.Project{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return project, nil
+}
+
+func (r *repository) GetProjects(ctx context.Context) ([]domain.Project, error) {
+	query := `SELECT * FROM projects ORDER BY key ASC`
+	var projects []domain.Project
+	err := pgxscan.Select(ctx, r.dbPool, &projects, query)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return projects, nil
+}
+
+func (r *repository) UpdateProject(ctx context.Context, projectID string, project domain.UpdateProjectRequest) error {
+	ub := r.sqlbuilder.NewUpdateBuilder()
+	ub.Update("projects")
+
+	if project.Name != nil {
+		ub.SetMore(ub.Assign("name", *project.Name))
+	}
+	if project.ReviewRequired != nil {
+		ub.SetMore(ub.Assign("review_required", *project.ReviewRequired))
+	}
+
+	ub.SetMore("updated_at = NOW()")
+	ub.Where(ub.Equal("id", projectID))
+
+	query, args := ub.Build()
+	_, err := r.dbPool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
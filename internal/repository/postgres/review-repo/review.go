@@ -0,0 +1,58 @@
+package reviewrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/repository/postgres/pgtx"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// CreateTaskReview records the review of a task in review and completes it when approved,
+// or sends it back to In Progress
+func (r *repository) CreateTaskReview(ctx context.Context, task domain.Task, review domain.ReviewTaskRequest, reviewerID string) (domain.TaskReview, error) {
+	tx, err := pgtx.From(ctx, r.dbPool).Begin(ctx)
+	if err != nil {
+		return domain.TaskReview{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	status := domain.StatusInProgress
+	if review.Approved {
+		status = domain.StatusCompleted
+	}
+	taskQuery := `UPDATE tasks SET status = $1, updated_by = $2, updated_at = NOW() WHERE id = $3 AND status = $4`
+	tag, err := tx.Exec(ctx, taskQuery, status, reviewerID, task.ID, domain.StatusInReview)
+	if err != nil {
+		return domain.TaskReview{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.TaskReview{}, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Task is not awaiting review")
+	}
+
+	query := `INSERT INTO task_reviews (task_id, reviewer_id, submitted_by, approved, feedback, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW()) RETURNING *`
+	var created domain.TaskReview
+	err = pgxscan.Get(ctx, tx, &created, query, task.ID, reviewerID, task.AssigneeID, review.Approved, review.Feedback)
+	if err != nil {
+		return domain.TaskReview{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.TaskReview{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+// GetTaskReviews returns the reviews of a task, latest first
+func (r *repository) GetTaskReviews(ctx context.Context, taskID string) ([]domain.TaskReview, error) {
+	query := `SELECT * FROM task_reviews WHERE task_id = $1 ORDER BY created_at DESC`
+	var reviews []domain.TaskReview
+	err := pgxscan.Select(ctx, r.dbPool, &reviews, query, taskID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return reviews, nil
+}
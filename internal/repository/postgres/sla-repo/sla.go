@@ -0,0 +1,203 @@
+package slarepo
+
+import (
+	"context"
+	"fmt"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (r *repository) CreateSLAPolicy(ctx context.Context, policy domain.CreateSLAPolicyRequest) (domain.SLAPolicy, error) {
+	query := `INSERT INTO sla_policies (name, labels, priority, project_id, precedence, start_on, response_minutes, resolution_minutes,
+			warn_before_minutes, escalation, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW()) RETURNING *`
+	var created domain.SLAPolicy
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, policy.Name, policy.Labels, policy.Priority, policy.ProjectID, policy.Precedence,
+		policy.StartOn, policy.ResponseMinutes, policy.ResolutionMinutes, policy.WarnBeforeMinutes, policy.Escalation)
+	if err != nil {
+		return domain.SLAPolicy{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) GetSLAPolicyByID(ctx context.Context, policyID string) (domain.SLAPolicy, error) {
+	query := `SELECT * FROM sla_policies WHERE id = $1`
+	var policy domain.SLAPolicy
+	err := pgxscan.Get(ctx, r.dbPool, &policy, query, policyID)
+	if pgxscan.NotFound(err) {
+		return domain.SLAPolicy{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "SLA policy not found")
+	}
+	if err != nil {
+		return domain.SLAPolicy{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return policy, nil
+}
+
+// GetSLAPolicies returns policies in the order they are matched against tasks
+func (r *repository) GetSLAPolicies(ctx context.Context) ([]domain.SLAPolicy, error) {
+	query := `SELECT * FROM sla_policies ORDER BY precedence ASC, created_at ASC`
+	var policies []domain.SLAPolicy
+	err := pgxscan.Select(ctx, r.dbPool, &policies, query)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return policies, nil
+}
+
+func (r *repository) UpdateSLAPolicy(ctx context.Context, policyID string, policy domain.UpdateSLAPolicyRequest) error {
+	ub := r.sqlbuilder.NewUpdateBuilder()
+	ub.Update("sla_policies")
+
+	if policy.Name != nil {
+		ub.SetMore(ub.Assign("name", *policy.Name))
+	}
+	if policy.Precedence != nil {
+		ub.SetMore(ub.Assign("precedence", *policy.Precedence))
+	}
+	if policy.ResponseMinutes != nil {
+		ub.SetMore(ub.Assign("response_minutes", *policy.ResponseMinutes))
+	}
+	if policy.ResolutionMinutes != nil {
+		ub.SetMore(ub.Assign("resolution_minutes", *policy.ResolutionMinutes))
+	}
+	if policy.WarnBeforeMinutes != nil {
+		ub.SetMore(ub.Assign("warn_before_minutes", *policy.WarnBeforeMinutes))
+	}
+	if policy.Escalation != nil {
+		ub.SetMore(ub.Assign("escalation", *policy.Escalation))
+	}
+
+	ub.SetMore("updated_at = NOW()")
+	ub.Where(ub.Equal("id", policyID))
+
+	query, args := ub.Build()
+	_, err := r.dbPool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// DeleteSLAPolicy deletes a policy. The clocks it started are kept for the breach stats but no longer escalate.
+func (r *repository) DeleteSLAPolicy(ctx context.Context, policyID string) error {
+	query := `DELETE FROM sla_policies WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, policyID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// StartTaskSLAs starts the clocks of open tasks without one whose first matching
+// policy starts on one of the given events. Policies starting on assignment only
+// start for assigned tasks.
+func (r *repository) StartTaskSLAs(ctx context.Context, taskIDs []string, starts []domain.SLAStart) error {
+	startOn := make([]string, 0, len(starts))
+	for _, start := range starts {
+		startOn = append(startOn, string(start))
+	}
+	query := `INSERT INTO task_slas (task_id, policy_id, started_at, response_due_at, resolution_due_at)
+		SELECT m.task_id, m.policy_id, NOW(), NOW() + make_interval(mins => m.response_minutes), NOW() + make_interval(mins => m.resolution_minutes)
+		FROM (
+			SELECT DISTINCT ON (t.id) t.id AS task_id, t.assignee_id, p.id AS policy_id, p.start_on, p.response_minutes, p.resolution_minutes
+			FROM tasks t
+			JOIN sla_policies p
+				ON (cardinality(p.labels) = 0 OR p.labels && t.labels)
+				AND (p.priority IS NULL OR p.priority = t.priority)
+				AND (p.project_id IS NULL OR p.project_id = t.project_id)
+			WHERE t.id = ANY($1::UUID[]) AND t.status <> 'Completed'
+			ORDER BY t.id, p.precedence ASC, p.created_at ASC
+		) m
+		WHERE m.start_on = ANY($2::TEXT[]) AND (m.start_on <> 'assigned' OR m.assignee_id IS NOT NULL)
+		ON CONFLICT (task_id) DO NOTHING`
+	_, err := r.dbPool.Exec(ctx, query, taskIDs, startOn)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) GetTaskSLA(ctx context.Context, taskID string) (domain.TaskSLA, error) {
+	query := `SELECT * FROM task_slas WHERE task_id = $1`
+	var sla domain.TaskSLA
+	err := pgxscan.Get(ctx, r.dbPool, &sla, query, taskID)
+	if pgxscan.NotFound(err) {
+		return domain.TaskSLA{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Task has no SLA")
+	}
+	if err != nil {
+		return domain.TaskSLA{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return sla, nil
+}
+
+// resumeShift is the time a task leaving Blocked spent paused, by which its open due dates move
+const resumeShift = `(CASE WHEN $2::TEXT <> 'Blocked' AND paused_at IS NOT NULL THEN NOW() - paused_at ELSE INTERVAL '0' END)`
+
+// UpdateTaskSLAStatus follows a status change on the SLA clock of a task: leaving Pending
+// meets the response target, Completed the resolution target, and Blocked pauses both.
+// Meeting the response target allows the task to be escalated again for its resolution target.
+func (r *repository) UpdateTaskSLAStatus(ctx context.Context, taskID string, status domain.TaskStatus) error {
+	query := fmt.Sprintf(`UPDATE task_slas SET
+			response_due_at = CASE WHEN responded_at IS NULL THEN response_due_at + %[1]s ELSE response_due_at END,
+			resolution_due_at = CASE WHEN resolved_at IS NULL THEN resolution_due_at + %[1]s ELSE resolution_due_at END,
+			response_breached = response_breached
+				OR COALESCE($2::TEXT <> 'Pending' AND responded_at IS NULL AND NOW() > response_due_at + %[1]s, FALSE),
+			resolution_breached = resolution_breached
+				OR COALESCE($2::TEXT = 'Completed' AND resolved_at IS NULL AND NOW() > resolution_due_at + %[1]s, FALSE),
+			escalated_at = CASE WHEN $2::TEXT <> 'Pending' AND responded_at IS NULL THEN NULL ELSE escalated_at END,
+			responded_at = CASE WHEN $2::TEXT <> 'Pending' THEN COALESCE(responded_at, NOW()) ELSE responded_at END,
+			resolved_at = CASE WHEN $2::TEXT = 'Completed' THEN COALESCE(resolved_at, NOW()) ELSE NULL END,
+			paused_at = CASE WHEN $2::TEXT = 'Blocked' THEN COALESCE(paused_at, NOW()) ELSE NULL END
+		WHERE task_id = $1`, resumeShift)
+	_, err := r.dbPool.Exec(ctx, query, taskID, string(status))
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// MarkSLABreaches flags the open targets of running clocks that are past due
+func (r *repository) MarkSLABreaches(ctx context.Context) (int64, error) {
+	query := `UPDATE task_slas SET
+			response_breached = response_breached OR COALESCE(responded_at IS NULL AND response_due_at < NOW(), FALSE),
+			resolution_breached = resolution_breached OR COALESCE(resolved_at IS NULL AND resolution_due_at < NOW(), FALSE)
+		WHERE paused_at IS NULL AND (
+			(NOT response_breached AND responded_at IS NULL AND response_due_at < NOW())
+			OR (NOT resolution_breached AND resolved_at IS NULL AND resolution_due_at < NOW()))`
+	tag, err := r.dbPool.Exec(ctx, query)
+	if err != nil {
+		return 0, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetSLAEscalations returns the running clocks, not yet escalated, with an open target
+// due within the warning time of their policy, most urgent first
+func (r *repository) GetSLAEscalations(ctx context.Context) ([]domain.SLAEscalationCandidate, error) {
+	query := `SELECT s.*, p.escalation, t.title AS task_title, t.assignee_id, t.labels, t.created_by
+		FROM task_slas s
+		JOIN sla_policies p ON p.id = s.policy_id
+		JOIN tasks t ON t.id = s.task_id
+		WHERE s.escalated_at IS NULL AND s.paused_at IS NULL AND (
+			(s.responded_at IS NULL AND s.response_due_at - make_interval(mins => p.warn_before_minutes) <= NOW())
+			OR (s.resolved_at IS NULL AND s.resolution_due_at - make_interval(mins => p.warn_before_minutes) <= NOW()))
+		ORDER BY LEAST(s.response_due_at, s.resolution_due_at) ASC`
+	var candidates []domain.SLAEscalationCandidate
+	err := pgxscan.Select(ctx, r.dbPool, &candidates, query)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return candidates, nil
+}
+
+func (r *repository) MarkSLAEscalated(ctx context.Context, taskID string, escalatedTo *string) error {
+	query := `UPDATE task_slas SET escalated_at = NOW(), escalated_to = $2 WHERE task_id = $1`
+	_, err := r.dbPool.Exec(ctx, query, taskID, escalatedTo)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
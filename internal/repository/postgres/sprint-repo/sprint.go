@@ -0,0 +1,204 @@
+package sprintrepo
+
+import (
+	"context"
+	"fmt"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// selectSprints selects sprints with the count and points of their tasks, to be grouped by sprint
+const selectSprints = `SELECT s.id, s.name, s.goal, TO_CHAR(s.start_date, 'YYYY-MM-DD') AS start_date, TO_CHAR(s.end_date, 'YYYY-MM-DD') AS end_date,
+		s.status, s.started_at, s.closed_at, s.closed_by, s.created_by, s.created_at, s.updated_at,
+		COUNT(t.id) AS task_count,
+		COALESCE(SUM(t.story_points), 0)::FLOAT8 AS story_points,
+		COUNT(t.id) FILTER (WHERE t.status = 'Completed') AS completed_tasks,
+		COALESCE(SUM(t.story_points) FILTER (WHERE t.status = 'Completed'), 0)::FLOAT8 AS completed_points
+	FROM sprints s
+	LEFT JOIN tasks t ON t.sprint_id = s.id`
+
+func (r *repository) CreateSprint(ctx context.Context, sprint domain.CreateSprintRequest, userID string) (domain.Sprint, error) {
+	query := `INSERT INTO sprints (name, goal, start_date, end_date, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3::TEXT::DATE, $4::TEXT::DATE, $5, NOW(), NOW()) RETURNING id`
+	var sprintID string
+	err := r.dbPool.QueryRow(ctx, query, sprint.Name, sprint.Goal, sprint.StartDate, sprint.EndDate, userID).Scan(&sprintID)
+	if err != nil {
+		return domain.Sprint{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return r.GetSprintByID(ctx, sprintID)
+}
+
+func (r *repository) GetSprintByID(ctx context.Context, sprintID string) (domain.Sprint, error) {
+	query := selectSprints + ` WHERE s.id = $1 GROUP BY s.id`
+	var sprint domain.Sprint
+	err := pgxscan.Get(ctx, r.dbPool, &sprint, query, sprintID)
+	if pgxscan.NotFound(err) {
+		return domain.Sprint{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Sprint not found")
+	}
+	if err != nil {
+		return domain.Sprint{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return sprint, nil
+}
+
+// GetSprints returns the sprints with a status, or all of them when empty, the latest first
+func (r *repository) GetSprints(ctx context.Context, status domain.SprintStatus) ([]domain.Sprint, error) {
+	query := selectSprints + ` WHERE $1 = '' OR s.status = $1 GROUP BY s.id ORDER BY s.start_date DESC, s.created_at DESC`
+	var sprints []domain.Sprint
+	err := pgxscan.Select(ctx, r.dbPool, &sprints, query, string(status))
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return sprints, nil
+}
+
+func (r *repository) UpdateSprint(ctx context.Context, sprintID string, sprint domain.UpdateSprintRequest) error {
+	ub := r.sqlbuilder.NewUpdateBuilder()
+	ub.Update("sprints")
+
+	if sprint.Name != nil {
+		ub.SetMore(ub.Assign("name", *sprint.Name))
+	}
+	if sprint.Goal != nil {
+		ub.SetMore(ub.Assign("goal", *sprint.Goal))
+	}
+	if sprint.StartDate != nil {
+		ub.SetMore(fmt.Sprintf("start_date = %s::TEXT::DATE", ub.Var(*sprint.StartDate)))
+	}
+	if sprint.EndDate != nil {
+		ub.SetMore(fmt.Sprintf("end_date = %s::TEXT::DATE", ub.Var(*sprint.EndDate)))
+	}
+
+	ub.SetMore("updated_at = NOW()")
+	ub.Where(ub.Equal("id", sprintID))
+
+	query, args := ub.Build()
+	_, err := r.dbPool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// DeleteSprint deletes a sprint, its tasks going back to the backlog
+func (r *repository) DeleteSprint(ctx context.Context, sprintID string) error {
+	query := `DELETE FROM sprints WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, sprintID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) StartSprint(ctx context.Context, sprintID string) error {
+	query := `UPDATE sprints SET status = 'active', started_at = NOW(), updated_at = NOW() WHERE id = $1 AND status = 'planned'`
+	tag, err := r.dbPool.Exec(ctx, query, sprintID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Only planned sprints can be started")
+	}
+	return nil
+}
+
+// CloseSprint closes an active sprint and moves its unfinished tasks to another sprint, or to
+// the backlog when carryOverSprintID is nil, in a single transaction. It returns the moved tasks.
+func (r *repository) CloseSprint(ctx context.Context, sprintID string, carryOverSprintID *string, userID string) ([]string, error) {
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query := `UPDATE sprints SET status = 'closed', closed_at = NOW(), closed_by = $2, updated_at = NOW() WHERE id = $1 AND status = 'active'`
+	tag, err := tx.Exec(ctx, query, sprintID, userID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, errors.NewCustomErrorWithMessage(constant.ErrCodeConflict, "Only active sprints can be closed")
+	}
+
+	// NOW() is the start of the transaction, so the carried tasks leave the sprint when it closes
+	carryQuery := `WITH carried AS (
+			SELECT id FROM tasks WHERE sprint_id = $1::UUID AND status <> 'Completed' FOR UPDATE
+		), removed AS (
+			INSERT INTO sprint_scope_changes (sprint_id, task_id, added, changed_by, changed_at)
+			SELECT $1::UUID, id, FALSE, $3, NOW() FROM carried
+		), added AS (
+			INSERT INTO sprint_scope_changes (sprint_id, task_id, added, changed_by, changed_at)
+			SELECT $2::UUID, id, TRUE, $3, NOW() FROM carried WHERE $2::UUID IS NOT NULL
+		)
+		UPDATE tasks SET sprint_id = $2::UUID FROM carried WHERE tasks.id = carried.id RETURNING tasks.id`
+	var carried []string
+	if err := pgxscan.Select(ctx, tx, &carried, carryQuery, sprintID, carryOverSprintID, userID); err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return carried, nil
+}
+
+// AddSprintTasks moves tasks to a sprint, out of the sprint they were in if any
+func (r *repository) AddSprintTasks(ctx context.Context, sprintID string, taskIDs []string, userID string) error {
+	query := `WITH moved AS (
+			SELECT id, sprint_id FROM tasks WHERE id = ANY($2::UUID[]) AND sprint_id IS DISTINCT FROM $1::UUID FOR UPDATE
+		), removed AS (
+			INSERT INTO sprint_scope_changes (sprint_id, task_id, added, changed_by, changed_at)
+			SELECT sprint_id, id, FALSE, $3, NOW() FROM moved WHERE sprint_id IS NOT NULL
+		), added AS (
+			INSERT INTO sprint_scope_changes (sprint_id, task_id, added, changed_by, changed_at)
+			SELECT $1::UUID, id, TRUE, $3, NOW() FROM moved
+		)
+		UPDATE tasks SET sprint_id = $1::UUID FROM moved WHERE tasks.id = moved.id`
+	_, err := r.dbPool.Exec(ctx, query, sprintID, taskIDs, userID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// RemoveSprintTask moves a task of a sprint back to the backlog
+func (r *repository) RemoveSprintTask(ctx context.Context, sprintID, taskID, userID string) error {
+	query := `WITH removed AS (
+			UPDATE tasks SET sprint_id = NULL WHERE id = $2 AND sprint_id = $1 RETURNING id
+		)
+		INSERT INTO sprint_scope_changes (sprint_id, task_id, added, changed_by, changed_at)
+		SELECT $1, id, FALSE, $3, NOW() FROM removed`
+	tag, err := r.dbPool.Exec(ctx, query, sprintID, taskID, userID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Task is not in the sprint")
+	}
+	return nil
+}
+
+// GetSprintScopeChanges returns the tasks added to and removed from a sprint, oldest change first
+func (r *repository) GetSprintScopeChanges(ctx context.Context, sprintID string) ([]domain.SprintScopeChange, error) {
+	query := `SELECT task_id, added, changed_at FROM sprint_scope_changes WHERE sprint_id = $1 ORDER BY changed_at ASC`
+	var changes []domain.SprintScopeChange
+	err := pgxscan.Select(ctx, r.dbPool, &changes, query, sprintID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return changes, nil
+}
+
+// GetSprintScopeTasks returns the tasks that have ever been in a sprint
+func (r *repository) GetSprintScopeTasks(ctx context.Context, sprintID string) ([]domain.Task, error) {
+	query := `SELECT * FROM tasks WHERE id IN (SELECT task_id FROM sprint_scope_changes WHERE sprint_id = $1)`
+	var tasks []domain.Task
+	err := pgxscan.Select(ctx, r.dbPool, &tasks, query, sprintID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tasks, nil
+}
@@ -0,0 +1,146 @@
+package taskrepo
+
+import (
+	"fmt"
+	"strings"
+
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/taskquery"
+
+	"github.com/huandu/go-sqlbuilder"
+)
+
+var dateColumns = map[taskquery.Field]string{
+	taskquery.FieldDue:     "due_date",
+	taskquery.FieldCreated: "created_at",
+	taskquery.FieldUpdated: "updated_at",
+}
+
+// whereTaskQuery compiles a parsed task query into parameterized conditions of sb
+func (r *repository) whereTaskQuery(sb *sqlbuilder.SelectBuilder, q *taskquery.Query) []string {
+	exprs := make([]string, 0, len(q.Terms))
+	for _, term := range q.Terms {
+		expr := r.termExpr(sb, term)
+		if term.Negated {
+			// IS NOT TRUE keeps rows where the condition is NULL, e.g. unassigned
+			// tasks for -assignee:alice
+			expr = fmt.Sprintf("(%s) IS NOT TRUE", expr)
+		}
+		exprs = append(exprs, expr)
+	}
+	return exprs
+}
+
+func (r *repository) termExpr(sb *sqlbuilder.SelectBuilder, term taskquery.Term) string {
+	expr := r.matchExpr(sb, term)
+	if term.Op == taskquery.OpNotEqual {
+		return fmt.Sprintf("(%s) IS NOT TRUE", expr)
+	}
+	return expr
+}
+
+func (r *repository) matchExpr(sb *sqlbuilder.SelectBuilder, term taskquery.Term) string {
+	switch term.Field {
+	case taskquery.FieldText:
+		pattern := containsPattern(term.Value)
+		return sb.Or(sb.ILike("title", pattern), sb.ILike("description", pattern))
+	case taskquery.FieldTitle:
+		return sb.ILike("title", containsPattern(term.Value))
+	case taskquery.FieldStatus:
+		return sb.Equal("status", term.Value)
+	case taskquery.FieldPriority:
+		return sb.Equal("priority", term.Value)
+	case taskquery.FieldLabel:
+		return fmt.Sprintf("%s = ANY(labels)", sb.Var(term.Value))
+	case taskquery.FieldAssignee:
+		if strings.EqualFold(term.Value, taskquery.AssigneeNone) {
+			return sb.IsNull("assignee_id")
+		}
+		return sb.In("assignee_id", r.userIDsByUsername(term.Value))
+	case taskquery.FieldCreator:
+		return sb.In("created_by", r.userIDsByUsername(term.Value))
+	case taskquery.FieldProject:
+		projects := r.sqlbuilder.NewSelectBuilder()
+		projects.Select("id").From("projects").Where(projects.Equal("key", term.Value))
+		return sb.In("project_id", projects)
+	case taskquery.FieldCustom:
+		return customExpr(sb, term)
+	default:
+		return dateExpr(sb, dateColumns[term.Field], term)
+	}
+}
+
+func (r *repository) userIDsByUsername(username string) *sqlbuilder.SelectBuilder {
+	users := r.sqlbuilder.NewSelectBuilder()
+	users.Select("id").From("users").Where(users.Equal("username", username))
+	return users
+}
+
+// dateExpr matches a whole day when the value was a calendar day
+func dateExpr(sb *sqlbuilder.SelectBuilder, column string, term taskquery.Term) string {
+	start, end := term.Time, term.Time
+	if term.DateOnly {
+		end = start.AddDate(0, 0, 1)
+	}
+	switch term.Op {
+	case taskquery.OpLess:
+		return sb.LessThan(column, start)
+	case taskquery.OpLessEqual:
+		if term.DateOnly {
+			return sb.LessThan(column, end)
+		}
+		return sb.LessEqualThan(column, end)
+	case taskquery.OpGreater:
+		if term.DateOnly {
+			return sb.GreaterEqualThan(column, end)
+		}
+		return sb.GreaterThan(column, end)
+	case taskquery.OpGreaterEqual:
+		return sb.GreaterEqualThan(column, start)
+	default:
+		if term.DateOnly {
+			return sb.And(sb.GreaterEqualThan(column, start), sb.LessThan(column, end))
+		}
+		return sb.Equal(column, start)
+	}
+}
+
+var comparisons = map[taskquery.Operator]string{
+	taskquery.OpLess:         "<",
+	taskquery.OpLessEqual:    "<=",
+	taskquery.OpGreater:      ">",
+	taskquery.OpGreaterEqual: ">=",
+}
+
+// customExpr matches a custom field value according to the type of the field
+func customExpr(sb *sqlbuilder.SelectBuilder, term taskquery.Term) string {
+	key := sb.Var(term.CustomKey)
+	text := fmt.Sprintf("custom_fields->>%s", key)
+	op, ordered := comparisons[term.Op]
+	if !ordered {
+		op = "="
+	}
+
+	switch term.CustomType {
+	case domain.CustomFieldText:
+		if term.Op == taskquery.OpMatch {
+			return fmt.Sprintf("%s ILIKE %s", text, sb.Var(containsPattern(term.Value)))
+		}
+		return fmt.Sprintf("%s = %s", text, sb.Var(term.Value))
+	case domain.CustomFieldNumber:
+		return fmt.Sprintf("(%s)::NUMERIC %s %s::NUMERIC", text, op, sb.Var(term.Value))
+	case domain.CustomFieldMultiEnum:
+		return fmt.Sprintf("custom_fields->%s @> jsonb_build_array(%s::TEXT)", key, sb.Var(term.Value))
+	case domain.CustomFieldUser:
+		return fmt.Sprintf("%s IN (SELECT id::TEXT FROM users WHERE username = %s)", text, sb.Var(term.Value))
+	default:
+		// enum values compare as text, as do dates in YYYY-MM-DD form
+		return fmt.Sprintf("%s %s %s", text, op, sb.Var(term.Value))
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func containsPattern(value string) string {
+	return "%" + likeEscaper.Replace(value) + "%"
+}
@@ -0,0 +1,73 @@
+package taskrepo
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/core/taskquery"
+
+	"github.com/huandu/go-sqlbuilder"
+)
+
+func TestWhereTaskQuery(t *testing.T) {
+	customTypes := map[string]domain.CustomFieldType{
+		"points":   domain.CustomFieldNumber,
+		"customer": domain.CustomFieldText,
+		"tags":     domain.CustomFieldMultiEnum,
+		"owner":    domain.CustomFieldUser,
+		"tier":     domain.CustomFieldEnum,
+	}
+	day := func(d int) time.Time { return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		query string
+		where string
+		args  []any
+	}{
+		{"report", "(title ILIKE $1 OR description ILIKE $2)", []any{"%report%", "%report%"}},
+		{"a -b", "(title ILIKE $1 OR description ILIKE $2) AND ((title ILIKE $3 OR description ILIKE $4)) IS NOT TRUE",
+			[]any{"%a%", "%a%", "%b%", "%b%"}},
+		{`title:"50%_off"`, "title ILIKE $1", []any{`%50\%\_off%`}},
+		{"status!=pending", "(status = $1) IS NOT TRUE", []any{"Pending"}},
+		{"priority:high", "priority = $1", []any{"high"}},
+		{"label:Backend", "$1 = ANY(labels)", []any{"backend"}},
+		{"assignee:none", "assignee_id IS NULL", nil},
+		{"-assignee:alice", "(assignee_id IN (SELECT id FROM users WHERE username = $1)) IS NOT TRUE", []any{"alice"}},
+		{"creator:bob", "created_by IN (SELECT id FROM users WHERE username = $1)", []any{"bob"}},
+		{"project:ops", "project_id IN (SELECT id FROM projects WHERE key = $1)", []any{"OPS"}},
+		{"due:2024-01-30", "(due_date >= $1 AND due_date < $2)", []any{day(30), day(31)}},
+		{"due<2024-01-30", "due_date < $1", []any{day(30)}},
+		{"due<=2024-01-30", "due_date < $1", []any{day(31)}},
+		{"due>2024-01-30", "due_date >= $1", []any{day(31)}},
+		{`created>="2024-01-01T10:00:00Z"`, "created_at >= $1", []any{time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)}},
+		{"cf.points>=3", "(custom_fields->>$1)::NUMERIC >= $2::NUMERIC", []any{"points", "3"}},
+		{"cf.customer:acme", "custom_fields->>$1 ILIKE $2", []any{"customer", "%acme%"}},
+		{"cf.customer=acme", "custom_fields->>$1 = $2", []any{"customer", "acme"}},
+		{"cf.tags:x", "custom_fields->$1 @> jsonb_build_array($2::TEXT)", []any{"tags", "x"}},
+		{"cf.owner:bob", "custom_fields->>$1 IN (SELECT id::TEXT FROM users WHERE username = $2)", []any{"owner", "bob"}},
+		{"cf.tier=gold", "custom_fields->>$1 = $2", []any{"tier", "gold"}},
+	}
+	r := &repository{sqlbuilder: sqlbuilder.PostgreSQL}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q, err := taskquery.Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.query, err)
+			}
+			for _, term := range q.CustomTerms() {
+				term.CustomType = customTypes[term.CustomKey]
+			}
+			sb := r.sqlbuilder.NewSelectBuilder()
+			sb.Select("*").From("tasks").Where(r.whereTaskQuery(sb, q)...)
+			sql, args := sb.Build()
+			if want := "SELECT * FROM tasks WHERE " + tt.where; sql != want {
+				t.Errorf("SQL = %s, want %s", sql, want)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
@@ -12,13 +12,16 @@ type repository struct {
 	dbPool     *pgxpool.Pool
 	scanApi    *pgxscan.API
 	sqlbuilder sqlbuilder.Flavor
+	// keyPrefix prefixes the keys of tasks without a project
+	keyPrefix string
 }
 
-func New(dbPool *pgxpool.Pool, scanApi *pgxscan.API, sqlbuilder sqlbuilder.Flavor) port.TaskRepository {
+func New(dbPool *pgxpool.Pool, scanApi *pgxscan.API, sqlbuilder sqlbuilder.Flavor, keyPrefix string) port.TaskRepository {
 
 	return &repository{
 		dbPool:     dbPool,
 		scanApi:    scanApi,
 		sqlbuilder: sqlbuilder,
+		keyPrefix:  keyPrefix,
 	}
 }
@@ -6,22 +6,78 @@ import (
 	"kn-assignment/internal/constant"
 	"kn-assignment/internal/core/domain"
 	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/core/rank"
+	"kn-assignment/internal/core/taskquery"
+	"kn-assignment/internal/repository/postgres/pgtx"
+	"strings"
+	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
 )
 
 func (r *repository) CreateTask(ctx context.Context, task domain.CreateTaskRequest, userId string) error {
-	query := `INSERT INTO tasks (title, description, due_date, created_at, created_by, updated_at, updated_by) VALUES ($1, $2, $3, NOW(), $4, NOW(), $4)`
-	_, err := r.dbPool.Exec(ctx, query, task.Title, task.Description, task.DueDate, userId)
+	_, err := r.CreateTasks(ctx, []domain.CreateTaskRequest{task}, userId)
+	return err
+}
+
+// CreateTasks inserts tasks and their checklists in a single transaction, so either all of them are created or none
+func (r *repository) CreateTasks(ctx context.Context, tasks []domain.CreateTaskRequest, userId string) ([]domain.Task, error) {
+	tx, err := pgtx.From(ctx, r.dbPool).Begin(ctx)
 	if err != nil {
-		return errors.NewCustomError(constant.ErrCodeInternalServer)
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
 	}
-	return nil
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query := `INSERT INTO tasks (title, description, due_date, labels, rank, assignee_id, checklist_total, project_id, custom_fields, estimated_hours,
+			priority, review_required, key, story_points, start_date, milestone, created_at, created_by, updated_at, updated_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $14, $15, $16, $17, NOW(), $13, NOW(), $13) RETURNING *`
+	itemQuery := `INSERT INTO checklist_items (task_id, content, position, created_at, updated_at) VALUES ($1, $2, $3, NOW(), NOW())`
+	created := make([]domain.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if task.CustomFields == nil {
+			task.CustomFields = map[string]any{}
+		}
+		if task.Priority == "" {
+			task.Priority = domain.PriorityMedium
+		}
+		key, err := r.nextKey(ctx, tx, task.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		var t domain.Task
+		err = pgxscan.Get(ctx, tx, &t, query, task.Title, task.Description, task.DueDate, task.Labels, task.Rank, task.AssigneeID,
+			len(task.Checklist), task.ProjectID, task.CustomFields, task.EstimatedHours, task.Priority, task.ReviewRequired, userId, key, task.StoryPoints,
+			task.StartDate, task.Milestone)
+		if err != nil {
+			return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+		}
+		if _, err := tx.Exec(ctx, `INSERT INTO task_keys (key, task_id, created_at) VALUES ($1, $2, NOW())`, key, t.ID); err != nil {
+			return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+		}
+		if task.AssigneeID != nil {
+			if _, err := tx.Exec(ctx, `UPDATE users SET last_assigned_at = NOW() WHERE id = $1`, *task.AssigneeID); err != nil {
+				return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+			}
+		}
+		for i, position := range rank.Spread(len(task.Checklist)) {
+			if _, err := tx.Exec(ctx, itemQuery, t.ID, task.Checklist[i], position); err != nil {
+				return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+			}
+		}
+		created = append(created, t)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
 }
 
 func (r *repository) AssignTask(ctx context.Context, taskID, assigneeID string) error {
-	query := `UPDATE tasks SET assignee_id = $1 WHERE id = $2`
-	_, err := r.dbPool.Exec(ctx, query, assigneeID, taskID)
+	query := `WITH assigned AS (UPDATE tasks SET assignee_id = $1, claimed_at = NULL WHERE id = $2 RETURNING id)
+		UPDATE users SET last_assigned_at = NOW() WHERE id = $1 AND EXISTS (SELECT 1 FROM assigned)`
+	_, err := pgtx.From(ctx, r.dbPool).Exec(ctx, query, assigneeID, taskID)
 	if err != nil {
 		return errors.NewCustomError(constant.ErrCodeInternalServer)
 	}
@@ -40,39 +96,65 @@ func (r *repository) GetTasksByAssignee(ctx context.Context, assigneeID string)
 
 func (r *repository) UpdateTaskStatus(ctx context.Context, taskID string, status domain.TaskStatus, userId string) error {
 	query := `UPDATE tasks SET status = $1, updated_by = $2, updated_at = NOW() WHERE id = $3`
-	_, err := r.dbPool.Exec(ctx, query, status, userId, taskID)
+	_, err := pgtx.From(ctx, r.dbPool).Exec(ctx, query, status, userId, taskID)
 	if err != nil {
 		return errors.NewCustomError(constant.ErrCodeInternalServer)
 	}
 	return nil
 }
 
-func (r *repository) GetAllTasks(ctx context.Context, filter map[string]string, sort, order string) ([]domain.Task, error) {
-	query := `SELECT * FROM tasks WHERE 1=1`
-	args := []interface{}{}
-	i := 1
+func (r *repository) GetAllTasks(ctx context.Context, filter map[string]string, query *taskquery.Query, sort, order string) ([]domain.Task, error) {
+	sb := r.sqlbuilder.NewSelectBuilder()
+	sb.Select("*").From("tasks")
 	for k, v := range filter {
-		query += fmt.Sprintf(" AND %s = $%d", k, i)
-		args = append(args, v)
-		i++
+		sb.Where(sb.Equal(k, v))
+	}
+	if !query.IsEmpty() {
+		sb.Where(r.whereTaskQuery(sb, query)...)
 	}
-	if sort != "" {
-		query += fmt.Sprintf(" ORDER BY %s %s", sort, order)
+	// the order is never written into the query as given
+	direction := "ASC"
+	if strings.EqualFold(order, "desc") {
+		direction = "DESC"
+	}
+	if key, ok := strings.CutPrefix(sort, taskquery.CustomFieldPrefix); ok {
+		// JSONB values of one type compare naturally: numbers numerically, dates as YYYY-MM-DD text
+		sb.OrderBy(fmt.Sprintf("custom_fields->%s %s", sb.Var(key), direction))
+	} else if sort != "" {
+		sb.OrderBy(fmt.Sprintf("%s %s", sort, direction))
 	} else {
-		query += " ORDER BY created_at DESC, status ASC"
+		sb.OrderBy("created_at DESC", "status ASC")
 	}
+	sql, args := sb.Build()
 	var tasks []domain.Task
-	err := pgxscan.Select(ctx, r.dbPool, &tasks, query, args...)
+	err := pgxscan.Select(ctx, r.dbPool, &tasks, sql, args...)
 	if err != nil {
 		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
 	}
 	return tasks, nil
 }
 
-func (r *repository) GetTaskSummary(ctx context.Context) ([]domain.TaskSummary, error) {
-	query := `SELECT assignee_id as employee_id, COUNT(*) as total_tasks, SUM(CASE WHEN status = 'Completed' THEN 1 ELSE 0 END) as completed_tasks FROM tasks WHERE assignee_id IS NOT NULL GROUP BY assignee_id`
+// GetTaskSummary summarizes the tasks of each employee, whose WIP limit is wipLimit unless they have their own
+func (r *repository) GetTaskSummary(ctx context.Context, wipLimit int) ([]domain.TaskSummary, error) {
+	query := `WITH reviews AS (
+			SELECT submitted_by, COUNT(*) FILTER (WHERE approved) AS approved_reviews, COUNT(*) FILTER (WHERE NOT approved) AS rejected_reviews
+			FROM task_reviews GROUP BY submitted_by
+		)
+		SELECT t.assignee_id as employee_id, COUNT(*) as total_tasks, SUM(CASE WHEN t.status = 'Completed' THEN 1 ELSE 0 END) as completed_tasks,
+			COUNT(s.task_id) as sla_tasks,
+			SUM(CASE WHEN s.response_breached THEN 1 ELSE 0 END) as response_breaches,
+			SUM(CASE WHEN s.resolution_breached THEN 1 ELSE 0 END) as resolution_breaches,
+			COALESCE(MAX(r.approved_reviews), 0) as approved_reviews,
+			COALESCE(MAX(r.rejected_reviews), 0) as rejected_reviews,
+			SUM(CASE WHEN t.status = 'In Progress' THEN 1 ELSE 0 END) as in_progress_tasks,
+			NULLIF(COALESCE(MAX(u.wip_limit), $1), 0) as wip_limit
+		FROM tasks t
+		LEFT JOIN task_slas s ON s.task_id = t.id
+		LEFT JOIN reviews r ON r.submitted_by = t.assignee_id
+		LEFT JOIN users u ON u.id = t.assignee_id
+		WHERE t.assignee_id IS NOT NULL GROUP BY t.assignee_id`
 	var summaries []domain.TaskSummary
-	err := pgxscan.Select(ctx, r.dbPool, &summaries, query)
+	err := pgxscan.Select(ctx, r.dbPool, &summaries, query, wipLimit)
 	if err != nil {
 		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
 	}
@@ -83,41 +165,166 @@ func (r *repository) GetTaskByID(ctx context.Context, taskID string) (domain.Tas
 	query := `SELECT * FROM tasks WHERE id = $1`
 	var task domain.Task
 	err := pgxscan.Get(ctx, r.dbPool, &task, query, taskID)
+	if pgxscan.NotFound(err) {
+		return domain.Task{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Task not found")
+	}
 	if err != nil {
 		return domain.Task{}, errors.NewCustomError(constant.ErrCodeInternalServer)
 	}
 	return task, nil
 }
 
-func (r *repository) UpdateTask(ctx context.Context, taskID string, name, description *string) error {
-	query := `UPDATE tasks SET`
-	args := []interface{}{}
-	i := 1
+func (r *repository) UpdateTask(ctx context.Context, taskID string, task domain.UpdateTaskRequest) error {
+	ub := r.sqlbuilder.NewUpdateBuilder()
+	ub.Update("tasks")
+
+	if task.Title != nil {
+		ub.SetMore(ub.Assign("title", *task.Title))
+	}
+
+	if task.Description != nil {
+		ub.SetMore(ub.Assign("description", *task.Description))
+	}
+
+	if task.Labels != nil {
+		ub.SetMore(ub.Assign("labels", *task.Labels))
+	}
+
+	if task.ProjectID != nil {
+		if *task.ProjectID == "" {
+			ub.SetMore(ub.Assign("project_id", nil))
+		} else {
+			ub.SetMore(ub.Assign("project_id", *task.ProjectID))
+		}
+	}
+
+	if task.EstimatedHours != nil {
+		ub.SetMore(ub.Assign("estimated_hours", *task.EstimatedHours))
+	}
+
+	if task.StoryPoints != nil {
+		ub.SetMore(ub.Assign("story_points", *task.StoryPoints))
+	}
+
+	if task.Milestone != nil {
+		ub.SetMore(ub.Assign("milestone", *task.Milestone))
+		if *task.Milestone {
+			ub.SetMore(ub.Assign("start_date", nil))
+		}
+	}
+	if task.StartDate != nil {
+		ub.SetMore(ub.Assign("start_date", *task.StartDate))
+	}
+
+	if task.Priority != nil {
+		ub.SetMore(ub.Assign("priority", *task.Priority))
+	}
 
-	if name != nil {
-		query += fmt.Sprintf(" name = $%d,", i)
-		args = append(args, *name)
-		i++
+	if task.ReviewRequired != nil {
+		ub.SetMore(ub.Assign("review_required", *task.ReviewRequired))
 	}
 
-	if description != nil {
-		query += fmt.Sprintf(" description = $%d,", i)
-		args = append(args, *description)
-		i++
+	// the service passes the complete custom field values
+	if task.CustomFields != nil {
+		ub.SetMore(ub.Assign("custom_fields", task.CustomFields))
 	}
 
-	query = query[:len(query)-1] // Remove the trailing comma
-	query += fmt.Sprintf(" WHERE id = $%d", i)
-	args = append(args, taskID)
+	ub.SetMore("updated_at = NOW()")
+	ub.Where(ub.Equal("id", taskID))
 
-	_, err := r.dbPool.Exec(ctx, query, args...)
+	tx, err := r.dbPool.Begin(ctx)
 	if err != nil {
 		return errors.NewCustomError(constant.ErrCodeInternalServer)
 	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query, args := ub.Build()
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if task.ProjectID != nil {
+		if err := r.rekey(ctx, tx, taskID); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// nextKey allocates the next key of a project, or of the tasks without a project. The counter
+// of the prefix stays locked until the transaction ends, so concurrent inserts wait for each
+// other and a rolled back insert gives its number back.
+func (r *repository) nextKey(ctx context.Context, tx pgx.Tx, projectID *string) (string, error) {
+	query := `INSERT INTO task_key_counters (prefix, last_number)
+		VALUES (COALESCE((SELECT key FROM projects WHERE id = $1), $2), 1)
+		ON CONFLICT (prefix) DO UPDATE SET last_number = task_key_counters.last_number + 1
+		RETURNING prefix || '-' || last_number`
+	var key string
+	if err := tx.QueryRow(ctx, query, projectID, r.keyPrefix).Scan(&key); err != nil {
+		return "", errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return key, nil
+}
+
+// rekey gives a task moved to another project the key it last had in that project,
+// or the next key of the project. Its previous keys remain aliases of the task.
+func (r *repository) rekey(ctx context.Context, tx pgx.Tx, taskID string) error {
+	var projectID *string
+	var prefix, key string
+	query := `SELECT t.project_id, COALESCE(p.key, $2), t.key FROM tasks t LEFT JOIN projects p ON p.id = t.project_id WHERE t.id = $1`
+	if err := tx.QueryRow(ctx, query, taskID, r.keyPrefix).Scan(&projectID, &prefix, &key); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if strings.HasPrefix(key, prefix+"-") {
+		return nil
+	}
 
+	query = `SELECT key FROM task_keys WHERE task_id = $1 AND key LIKE $2 || '-%' ORDER BY created_at DESC LIMIT 1`
+	err := tx.QueryRow(ctx, query, taskID, prefix).Scan(&key)
+	if pgxscan.NotFound(err) {
+		if key, err = r.nextKey(ctx, tx, projectID); err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `INSERT INTO task_keys (key, task_id, created_at) VALUES ($1, $2, NOW())`, key, taskID)
+	}
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE tasks SET key = $2 WHERE id = $1`, taskID, key); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
 	return nil
 }
 
+// GetTaskIDByKey returns the ID of the task with a key, current or former, in any case
+func (r *repository) GetTaskIDByKey(ctx context.Context, key string) (string, error) {
+	query := `SELECT task_id FROM task_keys WHERE key = UPPER($1)`
+	var taskID string
+	err := r.dbPool.QueryRow(ctx, query, key).Scan(&taskID)
+	if pgxscan.NotFound(err) {
+		return "", errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Task not found")
+	}
+	if err != nil {
+		return "", errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return taskID, nil
+}
+
+// GetTaskStatusChanges returns the status history of tasks, oldest change first
+func (r *repository) GetTaskStatusChanges(ctx context.Context, taskIDs []string) ([]domain.TaskStatusChange, error) {
+	query := `SELECT task_id, from_status, to_status, changed_by, changed_at FROM task_status_changes
+		WHERE task_id = ANY($1::UUID[]) ORDER BY changed_at ASC`
+	var changes []domain.TaskStatusChange
+	err := pgxscan.Select(ctx, r.dbPool, &changes, query, taskIDs)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return changes, nil
+}
+
 func (r *repository) DeleteTask(ctx context.Context, taskID string) error {
 	query := `DELETE FROM tasks WHERE id = $1`
 	_, err := r.dbPool.Exec(ctx, query, taskID)
@@ -126,3 +333,111 @@ func (r *repository) DeleteTask(ctx context.Context, taskID string) error {
 	}
 	return nil
 }
+
+func (r *repository) GetLastRank(ctx context.Context, status domain.TaskStatus) (string, error) {
+	query := `SELECT COALESCE(MAX(rank), '') FROM tasks WHERE status = $1`
+	var rank string
+	err := r.dbPool.QueryRow(ctx, query, status).Scan(&rank)
+	if err != nil {
+		return "", errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return rank, nil
+}
+
+func (r *repository) MoveTask(ctx context.Context, taskID string, status domain.TaskStatus, rank, userId string) error {
+	query := `UPDATE tasks SET status = $1, rank = $2, updated_by = $3, updated_at = NOW() WHERE id = $4`
+	_, err := pgtx.From(ctx, r.dbPool).Exec(ctx, query, status, rank, userId, taskID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// RankLast ranks a task at the bottom of its status column, as the status changes of a task
+// do, rebalancing the column when the rank gets too long
+func (r *repository) RankLast(ctx context.Context, taskID string, status domain.TaskStatus) error {
+	db := pgtx.From(ctx, r.dbPool)
+	query := `SELECT COALESCE(MAX(rank), '') FROM tasks WHERE status = $1 AND id <> $2`
+	var lastRank string
+	if err := db.QueryRow(ctx, query, status, taskID).Scan(&lastRank); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	newRank, err := rank.Between(lastRank, "")
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if _, err := db.Exec(ctx, `UPDATE tasks SET rank = $1 WHERE id = $2`, newRank, taskID); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if len(newRank) > rank.MaxLength {
+		return r.RebalanceRanks(ctx, status)
+	}
+	return nil
+}
+
+// RebalanceRanks spreads the ranks of a board column evenly, keeping the current order
+func (r *repository) RebalanceRanks(ctx context.Context, status domain.TaskStatus) error {
+	tx, err := pgtx.From(ctx, r.dbPool).Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	query := `SELECT id FROM tasks WHERE status = $1 ORDER BY rank ASC, created_at ASC FOR UPDATE`
+	var taskIDs []string
+	if err := pgxscan.Select(ctx, tx, &taskIDs, query, status); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	batch := &pgx.Batch{}
+	for i, newRank := range rank.Spread(len(taskIDs)) {
+		batch.Queue(`UPDATE tasks SET rank = $1 WHERE id = $2`, newRank, taskIDs[i])
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// GetWorkloads returns the open, overdue, estimated and in progress work of every employee.
+// Tasks without a due date are stored with the zero time and are never overdue.
+func (r *repository) GetWorkloads(ctx context.Context) ([]domain.Workload, error) {
+	query := `SELECT u.id AS user_id, u.username, u.skills, u.last_assigned_at, u.wip_limit,
+			COUNT(t.id) AS open_tasks,
+			COUNT(t.id) FILTER (WHERE t.due_date > '0001-01-01 00:00:00+00' AND t.due_date < NOW()) AS overdue_tasks,
+			COALESCE(SUM(t.estimated_hours), 0)::FLOAT8 AS estimated_hours,
+			COUNT(t.id) FILTER (WHERE t.status = $3) AS in_progress_tasks
+		FROM users u
+		LEFT JOIN tasks t ON t.assignee_id = u.id AND t.status <> $1
+		WHERE u.role = $2
+		GROUP BY u.id
+		ORDER BY u.username ASC`
+	var workloads []domain.Workload
+	err := pgxscan.Select(ctx, r.dbPool, &workloads, query, domain.StatusCompleted, domain.RoleEmployee, domain.StatusInProgress)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return workloads, nil
+}
+
+// GetTasksDueBetween returns the tasks due from start, included, to end, excluded, by due date
+func (r *repository) GetTasksDueBetween(ctx context.Context, start, end time.Time, assigneeID string) ([]domain.Task, error) {
+	sb := r.sqlbuilder.NewSelectBuilder()
+	sb.Select("*").From("tasks")
+	sb.Where(sb.GreaterEqualThan("due_date", start), sb.LessThan("due_date", end))
+	if assigneeID != "" {
+		sb.Where(sb.Equal("assignee_id", assigneeID))
+	}
+	sb.OrderBy("due_date ASC", "rank ASC")
+	sql, args := sb.Build()
+	var tasks []domain.Task
+	err := pgxscan.Select(ctx, r.dbPool, &tasks, sql, args...)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tasks, nil
+}
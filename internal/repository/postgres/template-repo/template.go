@@ -0,0 +1,96 @@
+package templaterepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (r *repository) CreateTaskTemplate(ctx context.Context, template domain.CreateTaskTemplateRequest, userID string) (domain.TaskTemplate, error) {
+	query := `INSERT INTO task_templates (name, title, description, due_offset_hours, due_offset_business_days, assignee_id, labels, checklist, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW()) RETURNING *`
+	var created domain.TaskTemplate
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, template.Name, template.Title, template.Description, template.DueOffsetHours, template.DueOffsetBusinessDays,
+		template.AssigneeID, template.Labels, template.Checklist, userID)
+	if err != nil {
+		return domain.TaskTemplate{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) GetTaskTemplateByID(ctx context.Context, templateID string) (domain.TaskTemplate, error) {
+	query := `SELECT * FROM task_templates WHERE id = $1`
+	var template domain.TaskTemplate
+	err := pgxscan.Get(ctx, r.dbPool, &template, query, templateID)
+	if pgxscan.NotFound(err) {
+		return domain.TaskTemplate{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "Template not found")
+	}
+	if err != nil {
+		return domain.TaskTemplate{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return template, nil
+}
+
+func (r *repository) GetTaskTemplates(ctx context.Context) ([]domain.TaskTemplate, error) {
+	query := `SELECT * FROM task_templates ORDER BY name ASC`
+	var templates []domain.TaskTemplate
+	err := pgxscan.Select(ctx, r.dbPool, &templates, query)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return templates, nil
+}
+
+func (r *repository) UpdateTaskTemplate(ctx context.Context, templateID string, template domain.UpdateTaskTemplateRequest) error {
+	ub := r.sqlbuilder.NewUpdateBuilder()
+	ub.Update("task_templates")
+
+	if template.Name != nil {
+		ub.SetMore(ub.Assign("name", *template.Name))
+	}
+	if template.Title != nil {
+		ub.SetMore(ub.Assign("title", *template.Title))
+	}
+	if template.Description != nil {
+		ub.SetMore(ub.Assign("description", *template.Description))
+	}
+	if template.DueOffsetHours != nil {
+		ub.SetMore(ub.Assign("due_offset_hours", *template.DueOffsetHours))
+		ub.SetMore("due_offset_business_days = NULL")
+	}
+	if template.DueOffsetBusinessDays != nil {
+		ub.SetMore(ub.Assign("due_offset_business_days", *template.DueOffsetBusinessDays))
+		ub.SetMore("due_offset_hours = NULL")
+	}
+	if template.AssigneeID != nil {
+		ub.SetMore(ub.Assign("assignee_id", *template.AssigneeID))
+	}
+	if template.Labels != nil {
+		ub.SetMore(ub.Assign("labels", *template.Labels))
+	}
+	if template.Checklist != nil {
+		ub.SetMore(ub.Assign("checklist", *template.Checklist))
+	}
+
+	ub.SetMore("updated_at = NOW()")
+	ub.Where(ub.Equal("id", templateID))
+
+	query, args := ub.Build()
+	_, err := r.dbPool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) DeleteTaskTemplate(ctx context.Context, templateID string) error {
+	query := `DELETE FROM task_templates WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, templateID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
@@ -27,8 +27,52 @@ func (r *repository) GetUserByID(ctx context.Context, userID string) (domain.Use
 	return user, err
 }
 
+func (r *repository) GetUsersByIDs(ctx context.Context, userIDs []string) ([]domain.User, error) {
+	query := `SELECT * FROM users WHERE id = ANY($1)`
+	var users []domain.User
+	err := pgxscan.Select(ctx, r.dbPool, &users, query, userIDs)
+	return users, err
+}
+
+func (r *repository) GetUsersByUsernames(ctx context.Context, usernames []string) ([]domain.User, error) {
+	query := `SELECT * FROM users WHERE username = ANY($1)`
+	var users []domain.User
+	err := pgxscan.Select(ctx, r.dbPool, &users, query, usernames)
+	return users, err
+}
+
+func (r *repository) UpdateUserSkills(ctx context.Context, userID string, skills []string) error {
+	query := `UPDATE users SET skills = $1, updated_at = NOW() WHERE id = $2`
+	_, err := r.dbPool.Exec(ctx, query, skills, userID)
+	return err
+}
+
+func (r *repository) UpdateUserTimezone(ctx context.Context, userID, timezone string) error {
+	query := `UPDATE users SET timezone = $1, updated_at = NOW() WHERE id = $2`
+	_, err := r.dbPool.Exec(ctx, query, timezone, userID)
+	return err
+}
+
+func (r *repository) UpdateUserClaimLimit(ctx context.Context, userID string, limit *int) error {
+	query := `UPDATE users SET claim_limit = $1, updated_at = NOW() WHERE id = $2`
+	_, err := r.dbPool.Exec(ctx, query, limit, userID)
+	return err
+}
+
+func (r *repository) UpdateUserWIPLimit(ctx context.Context, userID string, limit *int) error {
+	query := `UPDATE users SET wip_limit = $1, updated_at = NOW() WHERE id = $2`
+	_, err := r.dbPool.Exec(ctx, query, limit, userID)
+	return err
+}
+
 func (r *repository) UpdateUser(ctx context.Context, user domain.User) error {
 	query := `UPDATE users SET username = $1, password = $2, role = $3, updated_at = NOW() WHERE id = $4`
 	_, err := r.dbPool.Exec(ctx, query, user.Username, user.Password, user.Role, user.ID)
 	return err
 }
+
+func (r *repository) UpdateUserEmail(ctx context.Context, userID string, email *string) error {
+	query := `UPDATE users SET email = $1, updated_at = NOW() WHERE id = $2`
+	_, err := r.dbPool.Exec(ctx, query, email, userID)
+	return err
+}
@@ -0,0 +1,108 @@
+package viewrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+func (r *repository) CreateTaskView(ctx context.Context, view domain.CreateTaskViewRequest, ownerID string) (domain.TaskView, error) {
+	query := `INSERT INTO task_views (name, owner_id, visibility, is_default, filters, query, sort, sort_order, columns, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW()) RETURNING *`
+	var created domain.TaskView
+	err := pgxscan.Get(ctx, r.dbPool, &created, query, view.Name, ownerID, view.Visibility, view.IsDefault, view.Filters, view.Query, view.Sort, view.SortOrder, view.Columns)
+	if err != nil {
+		return domain.TaskView{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return created, nil
+}
+
+func (r *repository) GetTaskViewByID(ctx context.Context, viewID string) (domain.TaskView, error) {
+	query := `SELECT * FROM task_views WHERE id = $1`
+	var view domain.TaskView
+	err := pgxscan.Get(ctx, r.dbPool, &view, query, viewID)
+	if pgxscan.NotFound(err) {
+		return domain.TaskView{}, errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "View not found")
+	}
+	if err != nil {
+		return domain.TaskView{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return view, nil
+}
+
+// GetTaskViewsForUser returns the views owned by the user and the shared ones, default views first
+func (r *repository) GetTaskViewsForUser(ctx context.Context, userID string) ([]domain.TaskView, error) {
+	query := `SELECT * FROM task_views WHERE owner_id = $1 OR visibility = $2 ORDER BY is_default DESC, name ASC`
+	var views []domain.TaskView
+	err := pgxscan.Select(ctx, r.dbPool, &views, query, userID, domain.VisibilityShared)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return views, nil
+}
+
+// GetDefaultTaskView returns the default view published last, nil when there is none
+func (r *repository) GetDefaultTaskView(ctx context.Context) (*domain.TaskView, error) {
+	query := `SELECT * FROM task_views WHERE is_default AND visibility = $1 ORDER BY updated_at DESC LIMIT 1`
+	var view domain.TaskView
+	err := pgxscan.Get(ctx, r.dbPool, &view, query, domain.VisibilityShared)
+	if pgxscan.NotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return &view, nil
+}
+
+func (r *repository) UpdateTaskView(ctx context.Context, viewID string, view domain.UpdateTaskViewRequest) error {
+	ub := r.sqlbuilder.NewUpdateBuilder()
+	ub.Update("task_views")
+
+	if view.Name != nil {
+		ub.SetMore(ub.Assign("name", *view.Name))
+	}
+	if view.Visibility != nil {
+		ub.SetMore(ub.Assign("visibility", *view.Visibility))
+	}
+	if view.IsDefault != nil {
+		ub.SetMore(ub.Assign("is_default", *view.IsDefault))
+	}
+	if view.Filters != nil {
+		ub.SetMore(ub.Assign("filters", *view.Filters))
+	}
+	if view.Query != nil {
+		ub.SetMore(ub.Assign("query", *view.Query))
+	}
+	if view.Sort != nil {
+		ub.SetMore(ub.Assign("sort", *view.Sort))
+	}
+	if view.SortOrder != nil {
+		ub.SetMore(ub.Assign("sort_order", *view.SortOrder))
+	}
+	if view.Columns != nil {
+		ub.SetMore(ub.Assign("columns", *view.Columns))
+	}
+
+	ub.SetMore("updated_at = NOW()")
+	ub.Where(ub.Equal("id", viewID))
+
+	query, args := ub.Build()
+	_, err := r.dbPool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) DeleteTaskView(ctx context.Context, viewID string) error {
+	query := `DELETE FROM task_views WHERE id = $1`
+	_, err := r.dbPool.Exec(ctx, query, viewID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
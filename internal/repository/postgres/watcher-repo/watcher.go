@@ -0,0 +1,77 @@
+package watcherrepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// AutoWatchTasks makes the creators and assignees of the given tasks watch them,
+// keeping the mute of the ones already watching
+func (r *repository) AutoWatchTasks(ctx context.Context, taskIDs []string) error {
+	query := `INSERT INTO task_watchers (task_id, user_id, created_at)
+		SELECT t.id, u.id, NOW()
+		FROM tasks t
+		JOIN users u ON u.id = t.created_by OR u.id = t.assignee_id
+		WHERE t.id = ANY($1::UUID[])
+		ON CONFLICT (task_id, user_id) DO NOTHING`
+	_, err := r.dbPool.Exec(ctx, query, taskIDs)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// WatchTask makes a user watch a task, or sets the mute of a watcher
+func (r *repository) WatchTask(ctx context.Context, taskID, userID string, muted bool) (domain.TaskWatcher, error) {
+	query := `WITH watcher AS (
+			INSERT INTO task_watchers (task_id, user_id, muted, created_at) VALUES ($1, $2, $3, NOW())
+			ON CONFLICT (task_id, user_id) DO UPDATE SET muted = EXCLUDED.muted
+			RETURNING *
+		)
+		SELECT w.*, u.username FROM watcher w JOIN users u ON u.id = w.user_id`
+	var watcher domain.TaskWatcher
+	err := pgxscan.Get(ctx, r.dbPool, &watcher, query, taskID, userID, muted)
+	if err != nil {
+		return domain.TaskWatcher{}, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return watcher, nil
+}
+
+func (r *repository) UnwatchTask(ctx context.Context, taskID, userID string) error {
+	query := `DELETE FROM task_watchers WHERE task_id = $1 AND user_id = $2`
+	tag, err := r.dbPool.Exec(ctx, query, taskID, userID)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NewCustomErrorWithMessage(constant.ErrCodeNotFound, "You are not watching this task")
+	}
+	return nil
+}
+
+func (r *repository) GetTaskWatchers(ctx context.Context, taskID string) ([]domain.TaskWatcher, error) {
+	query := `SELECT w.*, u.username FROM task_watchers w JOIN users u ON u.id = w.user_id
+		WHERE w.task_id = $1 ORDER BY w.created_at ASC`
+	var watchers []domain.TaskWatcher
+	err := pgxscan.Select(ctx, r.dbPool, &watchers, query, taskID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return watchers, nil
+}
+
+// GetWatchedTasks returns the tasks a user watches, most recently updated first
+func (r *repository) GetWatchedTasks(ctx context.Context, userID string) ([]domain.Task, error) {
+	query := `SELECT t.* FROM tasks t JOIN task_watchers w ON w.task_id = t.id
+		WHERE w.user_id = $1 ORDER BY t.updated_at DESC`
+	var tasks []domain.Task
+	err := pgxscan.Select(ctx, r.dbPool, &tasks, query, userID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return tasks, nil
+}
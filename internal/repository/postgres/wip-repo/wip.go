@@ -0,0 +1,131 @@
+package wiprepo
+
+import (
+	"context"
+	"kn-assignment/internal/constant"
+	"kn-assignment/internal/core/domain"
+	errors "kn-assignment/internal/core/error"
+	"kn-assignment/internal/repository/postgres/pgtx"
+	"sort"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetStatusWIPLimits returns every status column in board order with its limit, if any, and its task count
+func (r *repository) GetStatusWIPLimits(ctx context.Context) ([]domain.StatusWIPLimit, error) {
+	query := `SELECT s.status, l.wip_limit, COUNT(t.id) AS task_count, l.updated_by, l.updated_at
+		FROM unnest(enum_range(NULL::task_status)) AS s(status)
+		LEFT JOIN status_wip_limits l ON l.status = s.status
+		LEFT JOIN tasks t ON t.status = s.status
+		GROUP BY s.status, l.wip_limit, l.updated_by, l.updated_at
+		ORDER BY s.status`
+	var limits []domain.StatusWIPLimit
+	err := pgxscan.Select(ctx, r.dbPool, &limits, query)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return limits, nil
+}
+
+// GetStatusWIPLimit returns the limit of a status column, nil when it has none
+func (r *repository) GetStatusWIPLimit(ctx context.Context, status domain.TaskStatus) (*int, error) {
+	query := `SELECT wip_limit FROM status_wip_limits WHERE status = $1`
+	var limit int
+	err := pgxscan.Get(ctx, r.dbPool, &limit, query, status)
+	if pgxscan.NotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return &limit, nil
+}
+
+// SetStatusWIPLimit sets the limit of a status column, or removes it when limit is nil
+func (r *repository) SetStatusWIPLimit(ctx context.Context, status domain.TaskStatus, limit *int, userID string) error {
+	query := `DELETE FROM status_wip_limits WHERE status = $1`
+	args := []any{status}
+	if limit != nil {
+		query = `INSERT INTO status_wip_limits (status, wip_limit, updated_by, updated_at) VALUES ($1, $2, $3, NOW())
+			ON CONFLICT (status) DO UPDATE SET wip_limit = EXCLUDED.wip_limit, updated_by = EXCLUDED.updated_by, updated_at = NOW()`
+		args = append(args, *limit, userID)
+	}
+	_, err := r.dbPool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// CountStatusTasks counts the tasks in a status, of an assignee when not nil, other than excludeTaskID
+// when not empty
+func (r *repository) CountStatusTasks(ctx context.Context, status domain.TaskStatus, assigneeID *string, excludeTaskID string) (int, error) {
+	query := `SELECT COUNT(*) FROM tasks
+		WHERE status = $1 AND ($2::UUID IS NULL OR assignee_id = $2::UUID) AND id::TEXT <> $3`
+	var count int
+	err := pgtx.From(ctx, r.dbPool).QueryRow(ctx, query, status, assigneeID, excludeTaskID).Scan(&count)
+	if err != nil {
+		return 0, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return count, nil
+}
+
+// LockWIP runs fn in a transaction holding the WIP limits of the status columns and employees,
+// so that tasks counted against a limit in fn cannot change until the transaction ends. The
+// limits are advisory locks rather than rows, as the changes in fn update the users.
+func (r *repository) LockWIP(ctx context.Context, statuses []domain.TaskStatus, employeeIDs []string, fn func(ctx context.Context) error) error {
+	tx, err := pgtx.From(ctx, r.dbPool).Begin(ctx)
+	if err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	// locks are taken in order so that concurrent changes cannot deadlock
+	keys := make([]string, 0, len(statuses)+len(employeeIDs))
+	for _, status := range statuses {
+		keys = append(keys, "wip:status:"+string(status))
+	}
+	for _, employeeID := range employeeIDs {
+		keys = append(keys, "wip:employee:"+employeeID)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, key); err != nil {
+			return errors.NewCustomError(constant.ErrCodeInternalServer)
+		}
+	}
+
+	if err := fn(pgtx.With(ctx, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+func (r *repository) CreateWIPOverrides(ctx context.Context, overrides []domain.WIPOverride) error {
+	batch := &pgx.Batch{}
+	for _, o := range overrides {
+		batch.Queue(`INSERT INTO wip_limit_overrides (task_id, scope, status, employee_id, wip_limit, task_count, note, overridden_by, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())`,
+			o.TaskID, o.Scope, o.Status, o.EmployeeID, o.WIPLimit, o.TaskCount, o.Note, o.OverriddenBy)
+	}
+	if err := pgtx.From(ctx, r.dbPool).SendBatch(ctx, batch).Close(); err != nil {
+		return errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return nil
+}
+
+// GetWIPOverrides returns the overrides of a task, or of all tasks when taskID is empty, the latest first
+func (r *repository) GetWIPOverrides(ctx context.Context, taskID string) ([]domain.WIPOverride, error) {
+	query := `SELECT id, task_id, scope, status, employee_id, wip_limit, task_count, note, overridden_by, created_at
+		FROM wip_limit_overrides WHERE $1 = '' OR task_id::TEXT = $1 ORDER BY created_at DESC`
+	var overrides []domain.WIPOverride
+	err := pgxscan.Select(ctx, r.dbPool, &overrides, query, taskID)
+	if err != nil {
+		return nil, errors.NewCustomError(constant.ErrCodeInternalServer)
+	}
+	return overrides, nil
+}
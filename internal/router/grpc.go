@@ -0,0 +1,45 @@
+package router
+
+import (
+	"kn-assignment/internal/core/domain"
+	"kn-assignment/internal/middleware"
+	authv1 "kn-assignment/pkg/pb/auth/v1"
+	taskv1 "kn-assignment/pkg/pb/task/v1"
+
+	"google.golang.org/grpc"
+)
+
+type GrpcHandlerList struct {
+	TaskServer taskv1.TaskServiceServer
+	AuthServer authv1.AuthServiceServer
+}
+
+// grpcMethodAccess mirrors the route groups of InitRouter
+var grpcMethodAccess = map[string]middleware.MethodAccess{
+	// auth methods
+	authv1.AuthService_RegisterUser_FullMethodName:     {Public: true},
+	authv1.AuthService_AuthenticateUser_FullMethodName: {Public: true},
+
+	// common methods
+	taskv1.TaskService_GetAllTasks_FullMethodName: {},
+
+	// employee methods
+	taskv1.TaskService_GetTasksByAssignee_FullMethodName: {},
+	taskv1.TaskService_UpdateTaskStatus_FullMethodName:   {},
+
+	// employer methods
+	taskv1.TaskService_CreateTask_FullMethodName:     {Role: domain.RoleEmployer},
+	taskv1.TaskService_AssignTask_FullMethodName:     {Role: domain.RoleEmployer},
+	taskv1.TaskService_GetTaskSummary_FullMethodName: {Role: domain.RoleEmployer},
+	taskv1.TaskService_UpdateTask_FullMethodName:     {Role: domain.RoleEmployer},
+	taskv1.TaskService_DeleteTask_FullMethodName:     {Role: domain.RoleEmployer},
+}
+
+func GrpcMethodAccess() map[string]middleware.MethodAccess {
+	return grpcMethodAccess
+}
+
+func InitGrpcRouter(srv *grpc.Server, h GrpcHandlerList) {
+	taskv1.RegisterTaskServiceServer(srv, h.TaskServer)
+	authv1.RegisterAuthServiceServer(srv, h.AuthServer)
+}
@@ -4,7 +4,25 @@ import (
 	"kn-assignment/docs"
 	"kn-assignment/internal/core/domain"
 	authhdl "kn-assignment/internal/handler/auth-hdl"
+	availabilityhdl "kn-assignment/internal/handler/availability-hdl"
+	checklisthdl "kn-assignment/internal/handler/checklist-hdl"
+	commenthdl "kn-assignment/internal/handler/comment-hdl"
+	customfieldhdl "kn-assignment/internal/handler/customfield-hdl"
+	graphqlhdl "kn-assignment/internal/handler/graphql-hdl"
+	handoffhdl "kn-assignment/internal/handler/handoff-hdl"
+	linkhdl "kn-assignment/internal/handler/link-hdl"
+	notificationhdl "kn-assignment/internal/handler/notification-hdl"
+	poolhdl "kn-assignment/internal/handler/pool-hdl"
+	projecthdl "kn-assignment/internal/handler/project-hdl"
+	reviewhdl "kn-assignment/internal/handler/review-hdl"
+	slahdl "kn-assignment/internal/handler/sla-hdl"
+	sprinthdl "kn-assignment/internal/handler/sprint-hdl"
 	taskhdl "kn-assignment/internal/handler/task-hdl"
+	templatehdl "kn-assignment/internal/handler/template-hdl"
+	userhdl "kn-assignment/internal/handler/user-hdl"
+	viewhdl "kn-assignment/internal/handler/view-hdl"
+	watcherhdl "kn-assignment/internal/handler/watcher-hdl"
+	wiphdl "kn-assignment/internal/handler/wip-hdl"
 	"kn-assignment/internal/middleware"
 
 	"kn-assignment/property"
@@ -17,8 +35,26 @@ import (
 )
 
 type HandlerList struct {
-	TaskHandler taskhdl.Handler
-	AuthHandler authhdl.Handler
+	TaskHandler         taskhdl.Handler
+	AuthHandler         authhdl.Handler
+	GraphqlHandler      graphqlhdl.Handler
+	ViewHandler         viewhdl.Handler
+	TemplateHandler     templatehdl.Handler
+	ChecklistHandler    checklisthdl.Handler
+	ProjectHandler      projecthdl.Handler
+	CustomFieldHandler  customfieldhdl.Handler
+	UserHandler         userhdl.Handler
+	SLAHandler          slahdl.Handler
+	HandoffHandler      handoffhdl.Handler
+	ReviewHandler       reviewhdl.Handler
+	LinkHandler         linkhdl.Handler
+	CommentHandler      commenthdl.Handler
+	PoolHandler         poolhdl.Handler
+	NotificationHandler notificationhdl.Handler
+	WatcherHandler      watcherhdl.Handler
+	SprintHandler       sprinthdl.Handler
+	WIPHandler          wiphdl.Handler
+	AvailabilityHandler availabilityhdl.Handler
 }
 
 const serviceBaseURL = "/api/v1"
@@ -42,6 +78,7 @@ func InitRouter(app *gin.Engine, h HandlerList) {
 
 	// common routes
 	v1.GET("/tasks", middleware.AuthMiddleware(), h.TaskHandler.GetAllTasks)
+	v1.POST("/graphql", middleware.AuthMiddleware(), h.GraphqlHandler.Query)
 
 	// auth routes
 	auth := v1.Group("/auth")
@@ -52,16 +89,109 @@ func InitRouter(app *gin.Engine, h HandlerList) {
 	// employee routes
 	employee := v1.Group("/")
 	employee.Use(middleware.AuthMiddleware())
+	employee.Use(h.TaskHandler.ResolveTaskKey)
+	employee.GET("/tasks/:taskID", h.TaskHandler.GetTask)
 	employee.GET("/tasks/assignee/:assigneeID", h.TaskHandler.GetTasksByAssignee)
+	employee.GET("/tasks/calendar", h.TaskHandler.GetTaskCalendar)
+	employee.GET("/tasks/timeline", h.TaskHandler.GetTaskTimeline)
 	employee.PATCH("/tasks/:taskID/status", h.TaskHandler.UpdateTaskStatus)
+	employee.PATCH("/tasks/:taskID/move", h.TaskHandler.MoveTask)
+	employee.GET("/tasks/:taskID/checklist", h.ChecklistHandler.GetChecklist)
+	employee.GET("/tasks/:taskID/sla", h.SLAHandler.GetTaskSLA)
+	employee.POST("/tasks/:taskID/handoffs", h.HandoffHandler.RequestHandoff)
+	employee.GET("/tasks/:taskID/handoffs", h.HandoffHandler.GetTaskHandoffs)
+	employee.GET("/tasks/:taskID/reviews", h.ReviewHandler.GetTaskReviews)
+	employee.GET("/tasks/:taskID/links", h.LinkHandler.GetTaskLinks)
+	employee.POST("/tasks/:taskID/links", h.LinkHandler.CreateTaskLink)
+	employee.DELETE("/tasks/:taskID/links/:linkID", h.LinkHandler.DeleteTaskLink)
+	employee.GET("/tasks/:taskID/comments", h.CommentHandler.GetTaskComments)
+	employee.POST("/tasks/:taskID/comments", h.CommentHandler.CreateTaskComment)
+	employee.PATCH("/tasks/:taskID/comments/:commentID", h.CommentHandler.UpdateTaskComment)
+	employee.DELETE("/tasks/:taskID/comments/:commentID", h.CommentHandler.DeleteTaskComment)
+	employee.GET("/tasks/:taskID/watchers", h.WatcherHandler.GetTaskWatchers)
+	employee.PUT("/tasks/:taskID/watch", h.WatcherHandler.WatchTask)
+	employee.DELETE("/tasks/:taskID/watch", h.WatcherHandler.UnwatchTask)
+	employee.PATCH("/tasks/:taskID/checklist/:itemID/done", h.ChecklistHandler.SetChecklistItemDone)
+	employee.PUT("/users/me/timezone", h.UserHandler.UpdateMyTimezone)
+	employee.PUT("/users/me/email", h.UserHandler.UpdateMyEmail)
+	employee.GET("/users/me/watching", h.WatcherHandler.GetWatchedTasks)
+	employee.PUT("/users/me/working-hours", h.AvailabilityHandler.SetMyWorkingHours)
+	employee.POST("/users/me/time-off", h.AvailabilityHandler.CreateMyTimeOff)
+	employee.GET("/users/:userID/availability", h.AvailabilityHandler.GetAvailability)
+	employee.DELETE("/time-off/:timeOffID", h.AvailabilityHandler.DeleteTimeOff)
+	employee.GET("/holidays", h.AvailabilityHandler.GetHolidays)
+	employee.GET("/notifications", h.NotificationHandler.GetNotifications)
+	employee.PATCH("/notifications/read-all", h.NotificationHandler.MarkAllNotificationsRead)
+	employee.GET("/notifications/preferences", h.NotificationHandler.GetNotificationPreferences)
+	employee.PUT("/notifications/preferences", h.NotificationHandler.UpdateNotificationPreferences)
+	employee.PATCH("/notifications/:notificationID/read", h.NotificationHandler.MarkNotificationRead)
+	employee.GET("/pool", h.PoolHandler.GetPoolTasks)
+	employee.POST("/pool/:taskID/claim", h.PoolHandler.ClaimTask)
+	employee.POST("/pool/:taskID/release", h.PoolHandler.ReleaseTask)
+	employee.GET("/projects", h.ProjectHandler.GetProjects)
+	employee.GET("/projects/:projectID", h.ProjectHandler.GetProject)
+	employee.GET("/sprints", h.SprintHandler.GetSprints)
+	employee.GET("/sprints/:sprintID", h.SprintHandler.GetSprint)
+	employee.GET("/sprints/:sprintID/burndown", h.SprintHandler.GetSprintBurndown)
+	employee.GET("/wip-limits", h.WIPHandler.GetWIPLimits)
+	employee.GET("/custom-fields", h.CustomFieldHandler.GetCustomFields)
+	employee.GET("/views", h.ViewHandler.GetTaskViews)
+	employee.POST("/views", h.ViewHandler.CreateTaskView)
+	employee.GET("/views/:viewID", h.ViewHandler.GetTaskView)
+	employee.PATCH("/views/:viewID", h.ViewHandler.UpdateTaskView)
+	employee.DELETE("/views/:viewID", h.ViewHandler.DeleteTaskView)
 
 	// employer routes
 	employer := v1.Group("/")
 	employer.Use(middleware.AuthMiddleware())
 	employer.Use(middleware.RoleMiddleware(domain.RoleEmployer))
+	employer.Use(h.TaskHandler.ResolveTaskKey)
 	employer.POST("/tasks", h.TaskHandler.CreateTask)
+	employer.POST("/tasks/quick", h.TaskHandler.QuickAddTask)
 	employer.PATCH("/tasks/:taskID/assign", h.TaskHandler.AssignTask)
+	employer.GET("/tasks/:taskID/assignee-suggestions", h.TaskHandler.SuggestAssignees)
+	employer.PUT("/users/:userID/skills", h.UserHandler.UpdateUserSkills)
+	employer.PUT("/users/:userID/claim-limit", h.UserHandler.UpdateUserClaimLimit)
+	employer.PUT("/users/:userID/wip-limit", h.UserHandler.UpdateUserWIPLimit)
+	employer.POST("/users/:userID/time-off", h.AvailabilityHandler.CreateUserTimeOff)
+	employer.PUT("/tasks/:taskID/pool", h.PoolHandler.PublishTask)
+	employer.DELETE("/tasks/:taskID/pool", h.PoolHandler.WithdrawTask)
 	employer.GET("/tasks/summary", h.TaskHandler.GetTaskSummary)
 	employer.PATCH("/tasks/:taskID", middleware.AuthMiddleware(), h.TaskHandler.UpdateTask)
 	employer.DELETE("/tasks/:taskID", middleware.AuthMiddleware(), h.TaskHandler.DeleteTask)
+	employer.POST("/tasks/:taskID/checklist", h.ChecklistHandler.AddChecklistItem)
+	employer.PATCH("/tasks/:taskID/checklist/:itemID", h.ChecklistHandler.UpdateChecklistItem)
+	employer.PATCH("/tasks/:taskID/checklist/:itemID/move", h.ChecklistHandler.MoveChecklistItem)
+	employer.DELETE("/tasks/:taskID/checklist/:itemID", h.ChecklistHandler.DeleteChecklistItem)
+	employer.POST("/projects", h.ProjectHandler.CreateProject)
+	employer.PATCH("/projects/:projectID", h.ProjectHandler.UpdateProject)
+	employer.POST("/tasks/:taskID/reviews", h.ReviewHandler.ReviewTask)
+	employer.POST("/tasks/:taskID/close-as-duplicate", h.LinkHandler.CloseAsDuplicate)
+	employer.POST("/sprints", h.SprintHandler.CreateSprint)
+	employer.PATCH("/sprints/:sprintID", h.SprintHandler.UpdateSprint)
+	employer.DELETE("/sprints/:sprintID", h.SprintHandler.DeleteSprint)
+	employer.POST("/sprints/:sprintID/start", h.SprintHandler.StartSprint)
+	employer.POST("/sprints/:sprintID/close", h.SprintHandler.CloseSprint)
+	employer.POST("/sprints/:sprintID/tasks", h.SprintHandler.AddSprintTasks)
+	employer.DELETE("/sprints/:sprintID/tasks/:taskID", h.SprintHandler.RemoveSprintTask)
+	employer.PUT("/wip-limits", h.WIPHandler.SetStatusWIPLimit)
+	employer.GET("/wip-limits/overrides", h.WIPHandler.GetWIPOverrides)
+	employer.POST("/holidays", h.AvailabilityHandler.CreateHoliday)
+	employer.DELETE("/holidays/:date", h.AvailabilityHandler.DeleteHoliday)
+	employer.POST("/custom-fields", h.CustomFieldHandler.CreateCustomField)
+	employer.PATCH("/custom-fields/:fieldID", h.CustomFieldHandler.UpdateCustomField)
+	employer.DELETE("/custom-fields/:fieldID", h.CustomFieldHandler.DeleteCustomField)
+	employer.GET("/handoffs", h.HandoffHandler.GetHandoffRequests)
+	employer.PATCH("/handoffs/:requestID/approve", h.HandoffHandler.ApproveHandoff)
+	employer.PATCH("/handoffs/:requestID/reject", h.HandoffHandler.RejectHandoff)
+	employer.GET("/sla-policies", h.SLAHandler.GetSLAPolicies)
+	employer.POST("/sla-policies", h.SLAHandler.CreateSLAPolicy)
+	employer.PATCH("/sla-policies/:policyID", h.SLAHandler.UpdateSLAPolicy)
+	employer.DELETE("/sla-policies/:policyID", h.SLAHandler.DeleteSLAPolicy)
+	employer.GET("/templates", h.TemplateHandler.GetTaskTemplates)
+	employer.POST("/templates", h.TemplateHandler.CreateTaskTemplate)
+	employer.GET("/templates/:templateID", h.TemplateHandler.GetTaskTemplate)
+	employer.PATCH("/templates/:templateID", h.TemplateHandler.UpdateTaskTemplate)
+	employer.DELETE("/templates/:templateID", h.TemplateHandler.DeleteTaskTemplate)
+	employer.POST("/templates/:templateID/tasks", h.TemplateHandler.CreateTasksFromTemplate)
 }
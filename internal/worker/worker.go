@@ -0,0 +1,33 @@
+// Package worker runs background jobs on a fixed interval.
+package worker
+
+import (
+	"context"
+	"time"
+
+	"kn-assignment/internal/log"
+)
+
+// Run calls job every interval until ctx is done. Errors are logged and the job
+// runs again on the next tick.
+func Run(ctx context.Context, name string, interval time.Duration, job func(context.Context) error) {
+	if interval <= 0 {
+		log.Infof(ctx, "%s worker disabled", name)
+		return
+	}
+	log.Infof(ctx, "%s worker running every %s", name, interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof(ctx, "%s worker stopped", name)
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				log.Errorf(ctx, "%s failed: %s", name, err.Error())
+			}
+		}
+	}
+}
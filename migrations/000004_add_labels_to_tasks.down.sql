@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_labels;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS labels;
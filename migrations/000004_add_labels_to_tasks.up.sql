@@ -0,0 +1,4 @@
+ALTER TABLE tasks
+ADD COLUMN labels TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_tasks_labels ON tasks USING GIN (labels);
@@ -0,0 +1,2 @@
+-- Drop the task views table
+DROP TABLE IF EXISTS task_views;
@@ -0,0 +1,18 @@
+-- Create the table for saved task list views
+CREATE TABLE task_views (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    visibility VARCHAR(20) NOT NULL DEFAULT 'private',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    filters JSONB NOT NULL DEFAULT '{}',
+    query TEXT NOT NULL DEFAULT '',
+    sort VARCHAR(50) NOT NULL DEFAULT '',
+    sort_order VARCHAR(4) NOT NULL DEFAULT 'asc',
+    columns TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_views_owner_id ON task_views (owner_id);
+CREATE INDEX idx_task_views_visibility ON task_views (visibility);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_status_rank;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS rank;
@@ -0,0 +1,14 @@
+-- Ranks are compared byte by byte, see internal/core/rank
+ALTER TABLE tasks
+ADD COLUMN rank VARCHAR(64) COLLATE "C" NOT NULL DEFAULT '';
+
+-- Rank existing tasks by creation order within their status column
+UPDATE tasks
+SET rank = ranked.rank
+FROM (
+    SELECT id, LPAD(ROW_NUMBER() OVER (PARTITION BY status ORDER BY created_at ASC)::TEXT, 10, '0') || '1' AS rank
+    FROM tasks
+) AS ranked
+WHERE tasks.id = ranked.id;
+
+CREATE INDEX idx_tasks_status_rank ON tasks (status, rank);
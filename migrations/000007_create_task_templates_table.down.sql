@@ -0,0 +1,2 @@
+-- Drop the task templates table
+DROP TABLE IF EXISTS task_templates;
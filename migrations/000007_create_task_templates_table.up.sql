@@ -0,0 +1,14 @@
+-- Create the table for reusable task templates
+CREATE TABLE task_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    due_offset_hours INTEGER,
+    assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    labels TEXT[] NOT NULL DEFAULT '{}',
+    checklist TEXT[] NOT NULL DEFAULT '{}',
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
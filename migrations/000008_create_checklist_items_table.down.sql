@@ -0,0 +1,6 @@
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS checklist_done,
+DROP COLUMN IF EXISTS checklist_total;
+
+-- Drop the checklist items table
+DROP TABLE IF EXISTS checklist_items;
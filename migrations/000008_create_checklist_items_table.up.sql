@@ -0,0 +1,20 @@
+-- Create the table for checklist items inside tasks
+CREATE TABLE checklist_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    done_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    done_at TIMESTAMP,
+    position VARCHAR(64) COLLATE "C" NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_checklist_items_task_id_position ON checklist_items (task_id, position);
+
+-- Checklist progress is kept on the task so that every task response can include it
+ALTER TABLE tasks
+ADD COLUMN checklist_total INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN checklist_done INTEGER NOT NULL DEFAULT 0;
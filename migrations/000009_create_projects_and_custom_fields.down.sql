@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_tasks_custom_fields;
+DROP INDEX IF EXISTS idx_tasks_project_id;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS custom_fields,
+DROP COLUMN IF EXISTS project_id;
+
+-- Drop the custom fields and projects tables
+DROP TABLE IF EXISTS custom_fields;
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_tasks_assignee_id_status;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS last_assigned_at,
+DROP COLUMN IF EXISTS skills;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS estimated_hours;
@@ -0,0 +1,10 @@
+ALTER TABLE tasks
+ADD COLUMN estimated_hours NUMERIC(6, 2);
+
+-- Skills are matched against task labels when suggesting assignees, and
+-- last_assigned_at drives round-robin auto-assignment
+ALTER TABLE users
+ADD COLUMN skills TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN last_assigned_at TIMESTAMP;
+
+CREATE INDEX idx_tasks_assignee_id_status ON tasks (assignee_id, status);
@@ -0,0 +1,19 @@
+DROP INDEX IF EXISTS idx_task_slas_open;
+
+-- Drop the SLA tables
+DROP TABLE IF EXISTS task_slas;
+DROP TABLE IF EXISTS sla_policies;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS priority;
+
+DROP TYPE IF EXISTS task_priority;
+
+-- Enum values cannot be dropped: recreate the status type without Blocked
+UPDATE tasks SET status = 'In Progress' WHERE status = 'Blocked';
+ALTER TABLE tasks ALTER COLUMN status DROP DEFAULT;
+ALTER TYPE task_status RENAME TO task_status_old;
+CREATE TYPE task_status AS ENUM ('Pending', 'In Progress', 'Completed');
+ALTER TABLE tasks ALTER COLUMN status TYPE task_status USING status::TEXT::task_status;
+ALTER TABLE tasks ALTER COLUMN status SET DEFAULT 'Pending';
+DROP TYPE task_status_old;
@@ -0,0 +1,43 @@
+-- Blocked tasks pause their SLA clocks
+ALTER TYPE task_status ADD VALUE IF NOT EXISTS 'Blocked' BEFORE 'Completed';
+
+-- Priorities are declared in ascending order so that sorting follows urgency
+CREATE TYPE task_priority AS ENUM ('low', 'medium', 'high', 'urgent');
+
+ALTER TABLE tasks
+ADD COLUMN priority task_priority NOT NULL DEFAULT 'medium';
+
+-- Create the table for SLA policies, matched against task labels, priority and project
+CREATE TABLE sla_policies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    labels TEXT[] NOT NULL DEFAULT '{}',
+    priority task_priority,
+    project_id UUID REFERENCES projects(id) ON DELETE CASCADE,
+    precedence INTEGER NOT NULL DEFAULT 0,
+    start_on VARCHAR(20) NOT NULL DEFAULT 'created',
+    response_minutes INTEGER,
+    resolution_minutes INTEGER,
+    warn_before_minutes INTEGER NOT NULL DEFAULT 0,
+    escalation VARCHAR(20) NOT NULL DEFAULT 'notify',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create the table for the SLA clock of each task under a policy
+CREATE TABLE task_slas (
+    task_id UUID PRIMARY KEY REFERENCES tasks(id) ON DELETE CASCADE,
+    policy_id UUID REFERENCES sla_policies(id) ON DELETE SET NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    response_due_at TIMESTAMP,
+    resolution_due_at TIMESTAMP,
+    responded_at TIMESTAMP,
+    resolved_at TIMESTAMP,
+    paused_at TIMESTAMP,
+    response_breached BOOLEAN NOT NULL DEFAULT FALSE,
+    resolution_breached BOOLEAN NOT NULL DEFAULT FALSE,
+    escalated_at TIMESTAMP,
+    escalated_to UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_task_slas_open ON task_slas (resolution_due_at) WHERE resolved_at IS NULL;
@@ -0,0 +1,8 @@
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS handoff_note;
+
+DROP INDEX IF EXISTS idx_handoff_requests_status_created_at;
+DROP INDEX IF EXISTS idx_handoff_requests_pending_task_id;
+
+-- Drop the handoff requests table
+DROP TABLE IF EXISTS handoff_requests;
@@ -0,0 +1,23 @@
+-- Create the table for employee requests to hand a task off to someone else
+CREATE TABLE handoff_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    reason TEXT NOT NULL,
+    suggested_assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    requested_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolution_note TEXT,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- A task has at most one pending request
+CREATE UNIQUE INDEX idx_handoff_requests_pending_task_id ON handoff_requests (task_id) WHERE status = 'pending';
+CREATE INDEX idx_handoff_requests_status_created_at ON handoff_requests (status, created_at);
+
+-- The reason of the last approved handoff stays on the task for its next assignee
+ALTER TABLE tasks
+ADD COLUMN handoff_note TEXT;
@@ -0,0 +1,20 @@
+DROP INDEX IF EXISTS idx_task_reviews_submitted_by;
+DROP INDEX IF EXISTS idx_task_reviews_task_id;
+
+-- Drop the task reviews table
+DROP TABLE IF EXISTS task_reviews;
+
+ALTER TABLE projects
+DROP COLUMN IF EXISTS review_required;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS review_required;
+
+-- Enum values cannot be dropped: recreate the status type without In Review
+UPDATE tasks SET status = 'In Progress' WHERE status = 'In Review';
+ALTER TABLE tasks ALTER COLUMN status DROP DEFAULT;
+ALTER TYPE task_status RENAME TO task_status_old;
+CREATE TYPE task_status AS ENUM ('Pending', 'In Progress', 'Blocked', 'Completed');
+ALTER TABLE tasks ALTER COLUMN status TYPE task_status USING status::TEXT::task_status;
+ALTER TABLE tasks ALTER COLUMN status SET DEFAULT 'Pending';
+DROP TYPE task_status_old;
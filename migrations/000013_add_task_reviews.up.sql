@@ -0,0 +1,23 @@
+-- Completed tasks requiring review wait for their creator in this status
+ALTER TYPE task_status ADD VALUE IF NOT EXISTS 'In Review' BEFORE 'Completed';
+
+-- Review is required by the task when set, otherwise by its project
+ALTER TABLE tasks
+ADD COLUMN review_required BOOLEAN;
+
+ALTER TABLE projects
+ADD COLUMN review_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create the table for the review outcomes of tasks
+CREATE TABLE task_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    submitted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    approved BOOLEAN NOT NULL,
+    feedback TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_reviews_task_id ON task_reviews (task_id, created_at);
+CREATE INDEX idx_task_reviews_submitted_by ON task_reviews (submitted_by);
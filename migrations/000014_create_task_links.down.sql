@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_task_links_target_task_id;
+DROP INDEX IF EXISTS idx_task_links_source_task_id;
+DROP INDEX IF EXISTS idx_task_links_pair_type;
+
+-- Drop the task links table
+DROP TABLE IF EXISTS task_links;
@@ -0,0 +1,15 @@
+-- Create the table for typed relations between tasks
+CREATE TABLE task_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    source_task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    target_task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (source_task_id <> target_task_id)
+);
+
+-- Two tasks are linked at most once per type, whichever the direction
+CREATE UNIQUE INDEX idx_task_links_pair_type ON task_links (LEAST(source_task_id, target_task_id), GREATEST(source_task_id, target_task_id), type);
+CREATE INDEX idx_task_links_source_task_id ON task_links (source_task_id);
+CREATE INDEX idx_task_links_target_task_id ON task_links (target_task_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_tasks_due_date;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE tasks
+ALTER COLUMN due_date TYPE TIMESTAMP USING due_date AT TIME ZONE 'UTC';
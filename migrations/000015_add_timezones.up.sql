@@ -0,0 +1,9 @@
+-- Due dates are instants, read on the local day of each user. Existing values were written in UTC.
+ALTER TABLE tasks
+ALTER COLUMN due_date TYPE TIMESTAMPTZ USING due_date AT TIME ZONE 'UTC';
+
+-- The IANA timezone calendars and due date searches are read in
+ALTER TABLE users
+ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+CREATE INDEX idx_tasks_due_date ON tasks (due_date);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_tasks_pooled;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS claim_limit;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS claimed_at,
+DROP COLUMN IF EXISTS pooled;
@@ -0,0 +1,10 @@
+-- Tasks published to the pool can be claimed by any employee while unassigned
+ALTER TABLE tasks
+ADD COLUMN pooled BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN claimed_at TIMESTAMP;
+
+-- claim_limit overrides the default number of pool tasks an employee can hold
+ALTER TABLE users
+ADD COLUMN claim_limit INTEGER;
+
+CREATE INDEX idx_tasks_pooled ON tasks (priority) WHERE pooled AND assignee_id IS NULL;
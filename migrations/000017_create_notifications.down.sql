@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_notifications_created_at;
+DROP INDEX IF EXISTS idx_notifications_task_id_type;
+DROP INDEX IF EXISTS idx_notifications_unread_user_id;
+DROP INDEX IF EXISTS idx_notifications_user_id_created_at;
+
+-- Drop the notifications table
+DROP TABLE IF EXISTS notifications;
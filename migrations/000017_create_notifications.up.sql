@@ -0,0 +1,16 @@
+-- Create the table for the in-app notifications of users
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    task_id UUID REFERENCES tasks(id) ON DELETE CASCADE,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    message TEXT NOT NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notifications_user_id_created_at ON notifications (user_id, created_at DESC);
+CREATE INDEX idx_notifications_unread_user_id ON notifications (user_id) WHERE read_at IS NULL;
+CREATE INDEX idx_notifications_task_id_type ON notifications (task_id, type);
+CREATE INDEX idx_notifications_created_at ON notifications (created_at);
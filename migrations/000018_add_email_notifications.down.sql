@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_notifications_email_pending;
+
+ALTER TABLE notifications
+DROP COLUMN IF EXISTS emailed_at,
+DROP COLUMN IF EXISTS email_attempts,
+DROP COLUMN IF EXISTS email_status,
+DROP COLUMN IF EXISTS in_app;
+
+DROP TABLE IF EXISTS notification_preferences;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS digest_sent_on,
+DROP COLUMN IF EXISTS email;
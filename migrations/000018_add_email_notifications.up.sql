@@ -0,0 +1,23 @@
+-- email is where notifications and digests are sent, digest_sent_on the local day of the last digest
+ALTER TABLE users
+ADD COLUMN email VARCHAR(254),
+ADD COLUMN digest_sent_on DATE;
+
+-- Per user overrides of the channels each notification type is delivered through
+CREATE TABLE notification_preferences (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    in_app BOOLEAN NOT NULL,
+    email BOOLEAN NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, type)
+);
+
+-- Notifications only sent by email are left out of the inbox, email_status is NULL when not emailed
+ALTER TABLE notifications
+ADD COLUMN in_app BOOLEAN NOT NULL DEFAULT TRUE,
+ADD COLUMN email_status VARCHAR(10),
+ADD COLUMN email_attempts INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN emailed_at TIMESTAMP;
+
+CREATE INDEX idx_notifications_email_pending ON notifications (created_at) WHERE email_status = 'pending';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_task_watchers_user_id;
+
+DROP TABLE IF EXISTS task_watchers;
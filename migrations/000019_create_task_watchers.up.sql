@@ -0,0 +1,14 @@
+-- Users following the changes of a task, muted watchers are not notified of them
+CREATE TABLE task_watchers (
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    muted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (task_id, user_id)
+);
+
+CREATE INDEX idx_task_watchers_user_id ON task_watchers (user_id);
+
+-- Creators and assignees watch their tasks
+INSERT INTO task_watchers (task_id, user_id)
+SELECT t.id, u.id FROM tasks t JOIN users u ON u.id = t.created_by OR u.id = t.assignee_id;
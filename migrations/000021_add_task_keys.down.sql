@@ -0,0 +1,9 @@
+ALTER TABLE tasks
+DROP CONSTRAINT IF EXISTS tasks_key_key,
+DROP COLUMN IF EXISTS key;
+
+DROP INDEX IF EXISTS idx_task_keys_task_id;
+
+DROP TABLE IF EXISTS task_keys;
+
+DROP TABLE IF EXISTS task_key_counters;
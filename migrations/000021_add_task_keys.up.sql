@@ -0,0 +1,37 @@
+-- Last number allocated per key prefix: the key of a project, or the prefix of tasks without a project.
+-- Allocating locks the row of the prefix until the transaction ends, so keys have no gaps.
+CREATE TABLE task_key_counters (
+    prefix VARCHAR(10) PRIMARY KEY,
+    last_number INTEGER NOT NULL
+);
+
+-- Every key a task has had, so that its keys keep working after moving it to another project
+CREATE TABLE task_keys (
+    key VARCHAR(21) PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_keys_task_id ON task_keys (task_id);
+
+ALTER TABLE tasks
+ADD COLUMN key VARCHAR(21);
+
+-- Number the existing tasks in creation order, with the default TASK prefix outside projects
+UPDATE tasks t SET key = n.prefix || '-' || n.number
+FROM (
+    SELECT t.id, COALESCE(p.key, 'TASK') AS prefix,
+        ROW_NUMBER() OVER (PARTITION BY COALESCE(p.key, 'TASK') ORDER BY t.created_at, t.id) AS number
+    FROM tasks t
+    LEFT JOIN projects p ON p.id = t.project_id
+) n
+WHERE n.id = t.id;
+
+ALTER TABLE tasks
+ALTER COLUMN key SET NOT NULL,
+ADD CONSTRAINT tasks_key_key UNIQUE (key);
+
+INSERT INTO task_keys (key, task_id) SELECT key, id FROM tasks;
+
+INSERT INTO task_key_counters (prefix, last_number)
+SELECT split_part(key, '-', 1), MAX(split_part(key, '-', 2)::INTEGER) FROM tasks GROUP BY 1;
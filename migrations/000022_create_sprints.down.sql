@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS tasks_status_change ON tasks;
+
+DROP FUNCTION IF EXISTS record_task_status_change();
+
+DROP TABLE IF EXISTS task_status_changes;
+
+DROP TABLE IF EXISTS sprint_scope_changes;
+
+DROP INDEX IF EXISTS idx_tasks_sprint_id;
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS story_points,
+DROP COLUMN IF EXISTS sprint_id;
+
+DROP TABLE IF EXISTS sprints;
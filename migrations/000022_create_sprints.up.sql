@@ -0,0 +1,70 @@
+-- Create the table of sprints, which time-box tasks between two days, both included
+CREATE TABLE sprints (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    goal TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'planned',
+    started_at TIMESTAMP,
+    closed_at TIMESTAMP,
+    closed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX idx_sprints_start_date ON sprints (start_date);
+
+-- A task is in at most one sprint, the backlog being the tasks in none
+ALTER TABLE tasks
+ADD COLUMN sprint_id UUID REFERENCES sprints(id) ON DELETE SET NULL,
+ADD COLUMN story_points NUMERIC(6, 1);
+
+CREATE INDEX idx_tasks_sprint_id ON tasks (sprint_id);
+
+-- The tasks added to and removed from sprints over time, for the scope line of burnup charts
+CREATE TABLE sprint_scope_changes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    sprint_id UUID NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    added BOOLEAN NOT NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_sprint_scope_changes_sprint_id ON sprint_scope_changes (sprint_id, changed_at);
+
+-- The status history of tasks. A trigger records every change, whichever query makes it.
+CREATE TABLE task_status_changes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50) NOT NULL,
+    -- changed_by is the updated_by of the task, which is not a foreign key either
+    changed_by UUID,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_status_changes_task_id ON task_status_changes (task_id, changed_at);
+
+CREATE FUNCTION record_task_status_change() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO task_status_changes (task_id, from_status, to_status, changed_by, changed_at)
+    VALUES (NEW.id, OLD.status, NEW.status, NEW.updated_by, NOW());
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_status_change
+AFTER UPDATE OF status ON tasks
+FOR EACH ROW
+WHEN (OLD.status IS DISTINCT FROM NEW.status)
+EXECUTE FUNCTION record_task_status_change();
+
+-- Tasks past Pending got there before history was kept, at their last update at the latest
+INSERT INTO task_status_changes (task_id, from_status, to_status, changed_by, changed_at)
+SELECT id, NULL, status, updated_by, updated_at
+FROM tasks
+WHERE status <> 'Pending';
@@ -0,0 +1,6 @@
+-- Dependencies are blocked_by task links, which earlier versions do not know
+DELETE FROM task_links WHERE type = 'blocked_by';
+
+ALTER TABLE tasks
+DROP COLUMN IF EXISTS milestone,
+DROP COLUMN IF EXISTS start_date;
@@ -0,0 +1,5 @@
+-- Tasks are scheduled from their start date to their due date. Milestones mark a point
+-- in time, their due date, and have no start date.
+ALTER TABLE tasks
+ADD COLUMN start_date TIMESTAMPTZ,
+ADD COLUMN milestone BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS wip_limit_overrides;
+DROP TABLE IF EXISTS status_wip_limits;
+
+ALTER TABLE users
+DROP COLUMN IF EXISTS wip_limit;
@@ -0,0 +1,27 @@
+-- wip_limit overrides the default number of In Progress tasks an employee can have
+ALTER TABLE users
+ADD COLUMN wip_limit INTEGER;
+
+-- Board columns hold at most wip_limit tasks
+CREATE TABLE IF NOT EXISTS status_wip_limits (
+    status task_status PRIMARY KEY,
+    wip_limit INTEGER NOT NULL CHECK (wip_limit > 0),
+    updated_by UUID NOT NULL REFERENCES users(id),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Employers can exceed a limit with a note, kept here for audit
+CREATE TABLE IF NOT EXISTS wip_limit_overrides (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    scope VARCHAR(16) NOT NULL CHECK (scope IN ('employee', 'status')),
+    status task_status NOT NULL,
+    employee_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    wip_limit INTEGER NOT NULL,
+    task_count INTEGER NOT NULL,
+    note TEXT NOT NULL,
+    overridden_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_wip_limit_overrides_created_at ON wip_limit_overrides (created_at DESC);
@@ -0,0 +1,7 @@
+ALTER TABLE task_templates
+DROP COLUMN IF EXISTS due_offset_business_days;
+
+DROP TABLE IF EXISTS holidays;
+DROP INDEX IF EXISTS idx_time_off_user_id;
+DROP TABLE IF EXISTS time_off;
+DROP TABLE IF EXISTS user_working_hours;
@@ -0,0 +1,36 @@
+-- Users work on days, ISO weekdays from 1 (Monday) to 7 (Sunday), from start_time to end_time
+-- in their timezone. Users without a row work Monday to Friday, 09:00 to 17:00.
+CREATE TABLE IF NOT EXISTS user_working_hours (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    days SMALLINT[] NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (start_time < end_time)
+);
+
+-- Time off spans days in the timezone of the user, both included
+CREATE TABLE IF NOT EXISTS time_off (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX idx_time_off_user_id ON time_off (user_id, end_date);
+
+-- Public holidays of the organization, off for everyone
+CREATE TABLE IF NOT EXISTS holidays (
+    date DATE PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Templates can set due dates a number of business days of the assignee ahead
+ALTER TABLE task_templates
+ADD COLUMN due_offset_business_days INTEGER;
@@ -72,6 +72,7 @@ type postgres struct {
 type secretConfig struct {
 	PostgresPasswordSecret string `envconfig:"POSTGRES_PASSWORD_SECRET"`
 	JWTSecretKey           string `envconfig:"JWT_SECRET_KEY"`
+	SMTPPassword           string `envconfig:"SMTP_PASSWORD"`
 }
 
 type PostgresConfig struct {
@@ -98,22 +99,37 @@ type PostgresConfig struct {
 }
 
 type ServerProperties struct {
-	DebugMode            bool   `envconfig:"DEBUG_MODE" long:"debug-mode" description:"turn on/off debug mode (default: false)" env:"DEBUG_MODE"`
-	PrintConsoleFormat   bool   `envconfig:"CONSOLE_FORMAT" long:"print-console-format" description:"log to print console format or not (default: false)" env:"CONSOLE_FORMAT"`
-	ShutdownTimeout      int64  `envconfig:"SHUTDOWN_TIMEOUT" long:"shutdown-timeout" description:"graceful shutdown timeout" env:"SHUTDOWN_TIMEOUT" default:"300"`
-	Port                 string `envconfig:"PORT" long:"port" description:"server running port" env:"PORT" `
-	ProjectID            string `envconfig:"GOOGLE_CLOUD_PROJECT" long:"project-id" description:"Google project id" env:"GOOGLE_CLOUD_PROJECT"`
-	ServiceName          string `envconfig:"SERVICE_NAME" long:"service-name" description:"Service name" env:"SERVICE_NAME"`
-	ServiceDescription   string `envconfig:"SERVICE_DESCRIPTION" long:"service-description" description:"Service description" env:"SERVICE_DESCRIPTION" default:""`
-	RunLocal             bool   `envconfig:"RUN_LOCAL" long:"run-local" description:"Is service running on local (default: false)" env:"RUN_LOCAL"`
-	LogIgnorePaths       string `envconfig:"LOG_IGNORE_PATHS" long:"log-ignore-paths" description:"url path to ignore logging (full path without host)" env:"LOG_IGNORE_PATHS"`
-	ApiDocs              bool   `envconfig:"API_DOCS" long:"api-docs" description:"expose api docs url (default: false)" env:"API_DOCS"`
-	ApiDocsSchema        string `envconfig:"API_DOCS_SCHEMA" long:"api-docs-schema" description:"Api docs schema" env:"API_DOCS_SCHEMA" default:"http"`
-	ApiDocsVersion       string `envconfig:"API_DOCS_VERSION" long:"api-docs-version" description:"Api docs version" env:"API_DOCS_VERSION" default:"v0.0.1"`
-	LogClientIgnorePaths string `envconfig:"LOG_CLIENT_IGNORE_PATHS" long:"log-client-ignore-paths" description:"url path to ignore client logging (full path without host)" env:"LOG_CLIENT_IGNORE_PATHS"`
-	Host                 string `envconfig:"HOST" long:"host" description:"Host" env:"HOST" default:"localhost"`
-	GinMode              string `envconfig:"GIN_MODE" long:"gin-mode" description:"Gin mode" env:"GIN_MODE"`
-	ClientLogMasking     bool   `envconfig:"CLIENT_LOG_MASKING" long:"client-log-masking" description:"Client log masking" env:"CLIENT_LOG_MASKING"`
+	DebugMode            bool          `envconfig:"DEBUG_MODE" long:"debug-mode" description:"turn on/off debug mode (default: false)" env:"DEBUG_MODE"`
+	PrintConsoleFormat   bool          `envconfig:"CONSOLE_FORMAT" long:"print-console-format" description:"log to print console format or not (default: false)" env:"CONSOLE_FORMAT"`
+	ShutdownTimeout      int64         `envconfig:"SHUTDOWN_TIMEOUT" long:"shutdown-timeout" description:"graceful shutdown timeout" env:"SHUTDOWN_TIMEOUT" default:"300"`
+	Port                 string        `envconfig:"PORT" long:"port" description:"server running port" env:"PORT" `
+	GrpcPort             string        `envconfig:"GRPC_PORT" long:"grpc-port" description:"grpc server running port" env:"GRPC_PORT" default:"9090"`
+	TaskKeyPrefix        string        `envconfig:"TASK_KEY_PREFIX" long:"task-key-prefix" description:"prefix of the keys of tasks without a project, such as TASK-42" env:"TASK_KEY_PREFIX" default:"TASK"`
+	AutoAssignStrategy   string        `envconfig:"AUTO_ASSIGN_STRATEGY" long:"auto-assign-strategy" description:"strategy of task auto-assignment: round_robin, least_loaded or skill_based" env:"AUTO_ASSIGN_STRATEGY" default:"least_loaded"`
+	SLACheckInterval     time.Duration `envconfig:"SLA_CHECK_INTERVAL" long:"sla-check-interval" description:"interval between SLA breach and escalation checks" env:"SLA_CHECK_INTERVAL" default:"1m"`
+	PoolClaimLimit       int           `envconfig:"POOL_CLAIM_LIMIT" long:"pool-claim-limit" description:"default number of pool tasks an employee can hold, 0 for no limit" env:"POOL_CLAIM_LIMIT" default:"3"`
+	WIPLimit             int           `envconfig:"WIP_LIMIT" long:"wip-limit" description:"default number of tasks an employee can have In Progress, 0 for no limit" env:"WIP_LIMIT" default:"0"`
+	NotificationInterval time.Duration `envconfig:"NOTIFICATION_CHECK_INTERVAL" long:"notification-check-interval" description:"interval between due soon notifications and retention cleanups" env:"NOTIFICATION_CHECK_INTERVAL" default:"5m"`
+	NotificationDueSoon  time.Duration `envconfig:"NOTIFICATION_DUE_SOON" long:"notification-due-soon" description:"how long before their due date assignees are notified, 0 to never notify" env:"NOTIFICATION_DUE_SOON" default:"24h"`
+	NotificationRetain   time.Duration `envconfig:"NOTIFICATION_RETENTION" long:"notification-retention" description:"how long notifications are kept, 0 to keep them forever" env:"NOTIFICATION_RETENTION" default:"720h"`
+	SMTPHost             string        `envconfig:"SMTP_HOST" long:"smtp-host" description:"SMTP server notifications are emailed through, empty to disable email" env:"SMTP_HOST" default:""`
+	SMTPPort             int           `envconfig:"SMTP_PORT" long:"smtp-port" description:"SMTP server port" env:"SMTP_PORT" default:"587"`
+	SMTPUsername         string        `envconfig:"SMTP_USERNAME" long:"smtp-username" description:"SMTP username, empty to send without authentication" env:"SMTP_USERNAME" default:""`
+	SMTPFrom             string        `envconfig:"SMTP_FROM" long:"smtp-from" description:"sender address of emails" env:"SMTP_FROM" default:"Task Manager <no-reply@localhost>"`
+	EmailSendInterval    time.Duration `envconfig:"EMAIL_SEND_INTERVAL" long:"email-send-interval" description:"interval between sends of pending emails and digests" env:"EMAIL_SEND_INTERVAL" default:"1m"`
+	DigestHour           int           `envconfig:"DIGEST_HOUR" long:"digest-hour" description:"hour of the day, in the timezone of each employee, the daily digest is sent from" env:"DIGEST_HOUR" default:"8"`
+	ProjectID            string        `envconfig:"GOOGLE_CLOUD_PROJECT" long:"project-id" description:"Google project id" env:"GOOGLE_CLOUD_PROJECT"`
+	ServiceName          string        `envconfig:"SERVICE_NAME" long:"service-name" description:"Service name" env:"SERVICE_NAME"`
+	ServiceDescription   string        `envconfig:"SERVICE_DESCRIPTION" long:"service-description" description:"Service description" env:"SERVICE_DESCRIPTION" default:""`
+	RunLocal             bool          `envconfig:"RUN_LOCAL" long:"run-local" description:"Is service running on local (default: false)" env:"RUN_LOCAL"`
+	LogIgnorePaths       string        `envconfig:"LOG_IGNORE_PATHS" long:"log-ignore-paths" description:"url path to ignore logging (full path without host)" env:"LOG_IGNORE_PATHS"`
+	ApiDocs              bool          `envconfig:"API_DOCS" long:"api-docs" description:"expose api docs url (default: false)" env:"API_DOCS"`
+	ApiDocsSchema        string        `envconfig:"API_DOCS_SCHEMA" long:"api-docs-schema" description:"Api docs schema" env:"API_DOCS_SCHEMA" default:"http"`
+	ApiDocsVersion       string        `envconfig:"API_DOCS_VERSION" long:"api-docs-version" description:"Api docs version" env:"API_DOCS_VERSION" default:"v0.0.1"`
+	LogClientIgnorePaths string        `envconfig:"LOG_CLIENT_IGNORE_PATHS" long:"log-client-ignore-paths" description:"url path to ignore client logging (full path without host)" env:"LOG_CLIENT_IGNORE_PATHS"`
+	Host                 string        `envconfig:"HOST" long:"host" description:"Host" env:"HOST" default:"localhost"`
+	GinMode              string        `envconfig:"GIN_MODE" long:"gin-mode" description:"Gin mode" env:"GIN_MODE"`
+	ClientLogMasking     bool          `envconfig:"CLIENT_LOG_MASKING" long:"client-log-masking" description:"Client log masking" env:"CLIENT_LOG_MASKING"`
 
 	AccessTokenExpiry  time.Duration `envconfig:"ACCESS_TOKEN_TIME" long:"access-token-time" description:"Access token expiry time" env:"ACCESS_TOKEN_TIME" default:"15m"`
 	RefreshTokenExpiry time.Duration `envconfig:"REFRESH_TOKEN_TIME" long:"refresh-token-time" description:"Refresh token expiry time" env:"REFRESH_TOKEN_TIME" default:"168h"`
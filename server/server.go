@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"kn-assignment/internal/log"
 	"kn-assignment/property"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -16,6 +17,7 @@ import (
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"google.golang.org/grpc"
 )
 
 func InitServer() *gin.Engine {
@@ -29,7 +31,11 @@ func InitServer() *gin.Engine {
 	return gin.New()
 }
 
-func StartServerWithCtx(ctx context.Context, h http.Handler, host string, port string) {
+func InitGrpcServer(opts ...grpc.ServerOption) *grpc.Server {
+	return grpc.NewServer(opts...)
+}
+
+func StartServerWithCtx(ctx context.Context, h http.Handler, grpcServer *grpc.Server, host string, port string, grpcPort string) {
 	server := &http.Server{
 		Addr:    fmt.Sprintf("%s:%s", host, port),
 		Handler: h,
@@ -42,6 +48,18 @@ func StartServerWithCtx(ctx context.Context, h http.Handler, host string, port s
 		}
 	}()
 
+	go func() {
+		lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", host, grpcPort))
+		if err != nil {
+			log.Errorf(ctx, "error grpc server listen: %v\n", err)
+			return
+		}
+		log.Infof(ctx, "grpc server running at port: %s", grpcPort)
+		if err := grpcServer.Serve(lis); err != nil && err != grpc.ErrServerStopped {
+			log.Errorf(ctx, "error grpc server serve: %v\n", err)
+		}
+	}()
+
 	gracefulStop := make(chan os.Signal, 1)
 	signal.Notify(gracefulStop, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
 	<-gracefulStop
@@ -51,10 +69,21 @@ func StartServerWithCtx(ctx context.Context, h http.Handler, host string, port s
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
 	if err := server.Shutdown(ctx); err != nil {
 		log.Errorf(ctx, "Shut down server error: %v\n", err)
 	}
 	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+	select {
 	case <-ctx.Done():
 		log.Info(ctx, "timeout of 10 seconds.\n")
 	default: